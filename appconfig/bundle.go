@@ -0,0 +1,419 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+)
+
+// BundleVersion is the version of the bundle format written by ExportAppConfig.
+const BundleVersion = 1
+
+// EncryptionKeyLength is the length of the AES-256 keys which encrypt the secrets of a bundle.
+const EncryptionKeyLength = 32
+
+// encryptedPrefix marks an encrypted secret: the base64 encoding of the GCM nonce followed by the ciphertext.
+const encryptedPrefix = "aes256gcm:"
+
+// Bundle : The platform configurations of an application, exported to move them to another application. It is meant
+// to be serialized as JSON.
+//
+// The push service does not return certificates, so the APNs and Safari configurations hold only the metadata of their
+// certificates; ImportAppConfig must be given the certificates themselves.
+type Bundle struct {
+	// The version of the bundle format.
+	Version int `json:"version"`
+
+	// The application the configurations were exported from.
+	ApplicationID string `json:"applicationId"`
+
+	// When the configurations were exported.
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// Whether the API keys are encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	Apns         *ApnsBundle       `json:"apns,omitempty"`
+	Gcm          *GcmSpec          `json:"gcm,omitempty"`
+	ChromeWeb    *ChromeWebSpec    `json:"chromeWeb,omitempty"`
+	FirefoxWeb   *FirefoxWebSpec   `json:"firefoxWeb,omitempty"`
+	SafariWeb    *SafariWebBundle  `json:"safariWeb,omitempty"`
+	ChromeAppExt *ChromeAppExtSpec `json:"chromeAppExt,omitempty"`
+}
+
+// ApnsBundle : The exported APNs configuration.
+type ApnsBundle struct {
+	// The name of the configured certificate.
+	Certificate string `json:"certificate,omitempty"`
+
+	// Whether the certificate is for the APNs sandbox environment.
+	IsSandBox bool `json:"isSandBox"`
+
+	// When the configured certificate expires, as reported by the push service.
+	ValidUntil string `json:"validUntil,omitempty"`
+}
+
+// SafariWebBundle : The exported Safari web push configuration.
+type SafariWebBundle struct {
+	// The name of the configured certificate.
+	Certificate string `json:"certificate,omitempty"`
+
+	// The website name. This is the heading used in Notification Center.
+	WebsiteName string `json:"websiteName"`
+
+	// The URL to go to when the notification is clicked, with %@ placeholders for the urlArgs of the notification.
+	UrlFormatString string `json:"urlFormatString"`
+
+	// Unique reverse-domain string for your Website Push ID, such as web.com.example.domain.
+	WebsitePushID string `json:"websitePushID"`
+
+	// The URL of the website that should be permitted to subscribe to Safari Push Notifications.
+	WebSiteURL string `json:"webSiteUrl"`
+}
+
+// Certificate : A certificate and its password, to import an APNs or Safari configuration.
+type Certificate struct {
+	// The certificate, in p12 format.
+	P12 []byte
+
+	// Password for the certificate.
+	Password string
+}
+
+// ImportOptions : The inputs ImportAppConfig needs besides the bundle.
+type ImportOptions struct {
+	// The key the API keys of the bundle were encrypted with, if they were.
+	EncryptionKey []byte
+
+	// The APNs certificate, required if the bundle holds an APNs configuration.
+	ApnsCertificate *Certificate
+
+	// The Website Push ID certificate, required if the bundle holds a Safari configuration.
+	SafariWebCertificate *Certificate
+
+	// A square image of at least pushservicev1.MinSafariIconSourceSize pixels for the Safari iconset. When nil, the
+	// push service uses its default icons.
+	SafariWebIcon []byte
+}
+
+// GenerateEncryptionKey returns a random key for ExportAppConfig and ImportAppConfig.
+func GenerateEncryptionKey() ([]byte, error) {
+	key := make([]byte, EncryptionKeyLength)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ExportAppConfig retrieves every platform configuration of an application: those listed by GetSettings, and the
+// Chrome App Extension configuration, which the settings do not list. When encryptionKey is set, the API keys in the
+// bundle are encrypted with it using AES-256-GCM.
+func ExportAppConfig(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, encryptionKey []byte) (*Bundle, error) {
+	settings, _, err := pushService.GetSettingsWithContext(ctx, pushService.NewGetSettingsOptions(applicationID))
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to retrieve the settings: %s", applicationID, err.Error())
+	}
+	bundle := &Bundle{Version: BundleVersion, ApplicationID: applicationID, ExportedAt: time.Now().UTC()}
+
+	// Each platform spec knows how to fetch its live configuration; an empty spec is enough to call it.
+	for _, platform := range []platformSpec{&ApnsSpec{}, &GcmSpec{}, &ChromeWebSpec{}, &FirefoxWebSpec{}, &SafariWebSpec{}, &ChromeAppExtSpec{}} {
+		if known, configured := platform.configuredIn(settings); known && !configured {
+			continue
+		}
+		live, err := platform.fetch(ctx, pushService, applicationID)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: unable to retrieve the configuration: %s", applicationID, platform.name(), err.Error())
+		}
+		if live != nil {
+			bundle.add(platform, live)
+		}
+	}
+
+	if encryptionKey != nil {
+		err = bundle.transformSecrets(func(field string, secret string) (string, error) {
+			return encryptSecret(encryptionKey, field, secret)
+		})
+		if err != nil {
+			return nil, err
+		}
+		bundle.Encrypted = true
+	}
+	return bundle, nil
+}
+
+// add stores the live configuration of a platform in the bundle.
+func (bundle *Bundle) add(platform platformSpec, live interface{}) {
+	switch platform.(type) {
+	case *ApnsSpec:
+		conf := live.(*pushservicev1.ApnsCertUploadResponse)
+		bundle.Apns = &ApnsBundle{
+			Certificate: stringValue(conf.Certificate),
+			IsSandBox:   conf.IsSandBox != nil && *conf.IsSandBox,
+			ValidUntil:  stringValue(conf.ValidUntil),
+		}
+	case *GcmSpec:
+		conf := live.(*pushservicev1.GCMCredendialsModel)
+		bundle.Gcm = &GcmSpec{ApiKey: stringValue(conf.ApiKey), SenderID: stringValue(conf.SenderID)}
+	case *ChromeWebSpec:
+		conf := live.(*pushservicev1.ChromeWebPushCredendialsModel)
+		bundle.ChromeWeb = &ChromeWebSpec{ApiKey: stringValue(conf.ApiKey), WebSiteURL: stringValue(conf.WebSiteURL)}
+	case *FirefoxWebSpec:
+		conf := live.(*pushservicev1.FirefoxWebPushCredendialsModel)
+		bundle.FirefoxWeb = &FirefoxWebSpec{WebSiteURL: stringValue(conf.WebSiteURL)}
+	case *SafariWebSpec:
+		conf := live.(*pushservicev1.SafariCertUploadResponse)
+		bundle.SafariWeb = &SafariWebBundle{
+			Certificate:     stringValue(conf.Certificate),
+			WebsiteName:     stringValue(conf.WebsiteName),
+			UrlFormatString: stringValue(conf.UrlFormatString),
+			WebsitePushID:   valueString(conf.WebsitePushID),
+			WebSiteURL:      valueString(conf.WebSiteURL),
+		}
+	case *ChromeAppExtSpec:
+		conf := live.(*pushservicev1.GCMCredendialsModel)
+		bundle.ChromeAppExt = &ChromeAppExtSpec{ApiKey: stringValue(conf.ApiKey), SenderID: stringValue(conf.SenderID)}
+	}
+}
+
+// transformSecrets replaces each API key of the bundle with the result of transform, given the name of its field.
+func (bundle *Bundle) transformSecrets(transform func(field string, secret string) (string, error)) (err error) {
+	secrets := make(map[string]*string)
+	if bundle.Gcm != nil {
+		secrets["gcm.apiKey"] = &bundle.Gcm.ApiKey
+	}
+	if bundle.ChromeWeb != nil {
+		secrets["chromeWeb.apiKey"] = &bundle.ChromeWeb.ApiKey
+	}
+	if bundle.ChromeAppExt != nil {
+		secrets["chromeAppExt.apiKey"] = &bundle.ChromeAppExt.ApiKey
+	}
+	for field, secret := range secrets {
+		if *secret, err = transform(field, *secret); err != nil {
+			return fmt.Errorf("%s: %s", field, err.Error())
+		}
+	}
+	return nil
+}
+
+// copy returns a copy of the bundle which shares none of the platform configurations.
+func (bundle *Bundle) copy() *Bundle {
+	copied := *bundle
+	if bundle.Gcm != nil {
+		gcm := *bundle.Gcm
+		copied.Gcm = &gcm
+	}
+	if bundle.ChromeWeb != nil {
+		chromeWeb := *bundle.ChromeWeb
+		copied.ChromeWeb = &chromeWeb
+	}
+	if bundle.ChromeAppExt != nil {
+		chromeAppExt := *bundle.ChromeAppExt
+		copied.ChromeAppExt = &chromeAppExt
+	}
+	return &copied
+}
+
+// encryptSecret encrypts a secret with AES-256-GCM. The name of its field is authenticated with it, so that encrypted
+// values cannot be swapped between fields.
+func encryptSecret(key []byte, field string, secret string) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(secret), []byte(field))
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret decrypts a secret encrypted by encryptSecret.
+func decryptSecret(key []byte, field string, encrypted string) (string, error) {
+	if !strings.HasPrefix(encrypted, encryptedPrefix) {
+		return "", fmt.Errorf("the value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encrypted, encryptedPrefix))
+	if err != nil {
+		return "", fmt.Errorf("the value is not valid base64: %s", err.Error())
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("the value is too short")
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(field))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt the value, the key may be wrong")
+	}
+	return string(secret), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeyLength {
+		return nil, fmt.Errorf("the encryption key must be %d bytes long, got %d", EncryptionKeyLength, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ImportAppConfig saves the configurations of a bundle to an application, which may belong to another push service
+// instance, and returns the platforms it configured. Platforms which are not in the bundle are left as they are.
+//
+// Everything the import needs, such as the certificates and the encryption key, is checked before any configuration
+// is saved; the import then stops at the first platform which fails.
+func ImportAppConfig(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, bundle *Bundle, options *ImportOptions) (imported []string, err error) {
+	if bundle == nil {
+		return nil, fmt.Errorf("the bundle is empty")
+	}
+	if bundle.Version == 0 {
+		return nil, fmt.Errorf("the bundle has no version, it is not an exported bundle")
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d", bundle.Version)
+	}
+	if options == nil {
+		options = new(ImportOptions)
+	}
+	bundle = bundle.copy()
+	if bundle.Encrypted {
+		if options.EncryptionKey == nil {
+			return nil, fmt.Errorf("the bundle is encrypted, but no encryption key was given")
+		}
+		err = bundle.transformSecrets(func(field string, secret string) (string, error) {
+			return decryptSecret(options.EncryptionKey, field, secret)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = options.check(bundle); err != nil {
+		return nil, err
+	}
+
+	for _, step := range importSteps(pushService, applicationID, bundle, options) {
+		if err = step.save(ctx); err != nil {
+			return imported, fmt.Errorf("%s %s: unable to import the configuration: %s", applicationID, step.platform, err.Error())
+		}
+		imported = append(imported, step.platform)
+	}
+	return imported, nil
+}
+
+// check verifies that the options hold the certificates the bundle needs, and that they can be used.
+func (options *ImportOptions) check(bundle *Bundle) error {
+	if bundle.Apns != nil {
+		if options.ApnsCertificate == nil {
+			return fmt.Errorf("the bundle holds an APNs configuration, but no APNs certificate was given")
+		}
+		info, err := pushservicev1.InspectApnsCertificate(options.ApnsCertificate.P12, options.ApnsCertificate.Password)
+		if err != nil {
+			return err
+		}
+		if err = info.Validate(bundle.Apns.IsSandBox); err != nil {
+			return err
+		}
+	}
+	if bundle.SafariWeb != nil && options.SafariWebCertificate == nil {
+		return fmt.Errorf("the bundle holds a Safari configuration, but no Website Push ID certificate was given")
+	}
+	return nil
+}
+
+// importStep saves the configuration of one platform.
+type importStep struct {
+	platform string
+	save     func(ctx context.Context) error
+}
+
+// importSteps returns the steps which save the configurations of the bundle, in the order of a spec.
+func importSteps(pushService *pushservicev1.PushServiceV1, applicationID string, bundle *Bundle, options *ImportOptions) (steps []importStep) {
+	if bundle.Apns != nil {
+		steps = append(steps, importStep{pushservicev1.CredentialPlatform_Apns, func(ctx context.Context) error {
+			_, _, err := pushService.SaveApnsConfWithContext(ctx, pushService.NewSaveApnsConfOptions(applicationID,
+				options.ApnsCertificate.Password, bundle.Apns.IsSandBox, ioutil.NopCloser(bytes.NewReader(options.ApnsCertificate.P12))))
+			return err
+		}})
+	}
+	// The platforms without certificates are saved as a spec would save them.
+	if bundle.Gcm != nil {
+		steps = append(steps, specStep(pushService, applicationID, bundle.Gcm))
+	}
+	if bundle.ChromeWeb != nil {
+		steps = append(steps, specStep(pushService, applicationID, bundle.ChromeWeb))
+	}
+	if bundle.FirefoxWeb != nil {
+		steps = append(steps, specStep(pushService, applicationID, bundle.FirefoxWeb))
+	}
+	if bundle.SafariWeb != nil {
+		steps = append(steps, importStep{pushservicev1.CredentialPlatform_SafariWeb, func(ctx context.Context) error {
+			saveSafariWebConfOptions := pushService.NewSaveSafariWebConfOptions(applicationID, options.SafariWebCertificate.Password,
+				ioutil.NopCloser(bytes.NewReader(options.SafariWebCertificate.P12)), bundle.SafariWeb.WebsiteName,
+				bundle.SafariWeb.UrlFormatString, bundle.SafariWeb.WebsitePushID, bundle.SafariWeb.WebSiteURL)
+			if options.SafariWebIcon != nil {
+				if err := saveSafariWebConfOptions.GenerateIcons(bytes.NewReader(options.SafariWebIcon)); err != nil {
+					return err
+				}
+			}
+			_, _, err := pushService.SaveSafariWebConfWithContext(ctx, saveSafariWebConfOptions)
+			return err
+		}})
+	}
+	if bundle.ChromeAppExt != nil {
+		steps = append(steps, specStep(pushService, applicationID, bundle.ChromeAppExt))
+	}
+	return steps
+}
+
+// specStep returns the step which saves a platform spec which needs no file.
+func specStep(pushService *pushservicev1.PushServiceV1, applicationID string, platform platformSpec) importStep {
+	return importStep{platform.name(), func(ctx context.Context) error {
+		return platform.save(ctx, pushService, applicationID, nil)
+	}}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// valueString returns a value the service returns untyped as a string.
+func valueString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfig
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCertificate(t *testing.T, path string) *Certificate {
+	p12, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	return &Certificate{P12: p12, Password: "secret"}
+}
+
+func TestExportAndImport(t *testing.T) {
+	_, source := newFakePushService(t, map[string]map[string]interface{}{
+		"apnsConf":         {"certificate": "certificate.p12", "isSandBox": true, "validUntil": fixtureValidUntil},
+		"gcmConf":          {"apiKey": "gcm-api-key", "senderId": "1234"},
+		"firefoxWebConf":   {"webSiteUrl": "https://example.com"},
+		"safariWebConf":    {"certificate": "certificate.p12", "websiteName": "Example", "urlFormatString": "https://example.com/%@", "websitePushID": "web.com.example", "webSiteUrl": "https://example.com"},
+		"chromeAppExtConf": {"apiKey": "app-ext-api-key", "senderId": "5678"},
+	})
+	key, err := GenerateEncryptionKey()
+	require.Nil(t, err)
+
+	bundle, err := ExportAppConfig(context.Background(), source, "testString", key)
+	require.Nil(t, err)
+	assert.Equal(t, BundleVersion, bundle.Version)
+	assert.True(t, bundle.Encrypted)
+	assert.Equal(t, &ApnsBundle{Certificate: "certificate.p12", IsSandBox: true, ValidUntil: fixtureValidUntil}, bundle.Apns)
+	assert.Equal(t, "1234", bundle.Gcm.SenderID)
+	assert.Nil(t, bundle.ChromeWeb)
+	assert.Equal(t, "web.com.example", bundle.SafariWeb.WebsitePushID)
+	assert.Equal(t, "5678", bundle.ChromeAppExt.SenderID)
+
+	serialized, err := json.Marshal(bundle)
+	require.Nil(t, err)
+	assert.NotContains(t, string(serialized), "gcm-api-key")
+	assert.NotContains(t, string(serialized), "app-ext-api-key")
+	assert.True(t, strings.HasPrefix(bundle.Gcm.ApiKey, encryptedPrefix))
+
+	var decoded Bundle
+	require.Nil(t, json.Unmarshal(serialized, &decoded))
+	target, destination := newFakePushService(t, map[string]map[string]interface{}{})
+	imported, err := ImportAppConfig(context.Background(), destination, "testString", &decoded, &ImportOptions{
+		EncryptionKey:        key,
+		ApnsCertificate:      readCertificate(t, "testdata/apns_sandbox.p12"),
+		SafariWebCertificate: readCertificate(t, "testdata/website_push_id.p12"),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"apns", "gcm", "firefoxWeb", "safariWeb", "chromeAppExt"}, imported)
+	assert.Equal(t, true, target.confs["apnsConf"]["isSandBox"])
+	assert.Equal(t, "gcm-api-key", target.confs["gcmConf"]["apiKey"])
+	assert.Equal(t, "app-ext-api-key", target.confs["chromeAppExtConf"]["apiKey"])
+	assert.Equal(t, "Example", target.confs["safariWebConf"]["websiteName"])
+	assert.Equal(t, "https://example.com", target.confs["firefoxWebConf"]["webSiteUrl"])
+
+	// The imported bundle is left encrypted.
+	assert.True(t, strings.HasPrefix(decoded.Gcm.ApiKey, encryptedPrefix))
+}
+
+func TestImportErrors(t *testing.T) {
+	_, source := newFakePushService(t, map[string]map[string]interface{}{
+		"apnsConf": {"certificate": "certificate.p12", "isSandBox": false, "validUntil": fixtureValidUntil},
+		"gcmConf":  {"apiKey": "gcm-api-key", "senderId": "1234"},
+	})
+	key, err := GenerateEncryptionKey()
+	require.Nil(t, err)
+	bundle, err := ExportAppConfig(context.Background(), source, "testString", key)
+	require.Nil(t, err)
+	otherKey, err := GenerateEncryptionKey()
+	require.Nil(t, err)
+	sandboxCertificate := readCertificate(t, "testdata/apns_sandbox.p12")
+
+	target, destination := newFakePushService(t, map[string]map[string]interface{}{})
+	for name, options := range map[string]*ImportOptions{
+		"no key":              {ApnsCertificate: sandboxCertificate},
+		"wrong key":           {EncryptionKey: otherKey, ApnsCertificate: sandboxCertificate},
+		"short key":           {EncryptionKey: key[:16], ApnsCertificate: sandboxCertificate},
+		"missing certificate": {EncryptionKey: key},
+		"sandbox certificate": {EncryptionKey: key, ApnsCertificate: sandboxCertificate},
+		"wrong password":      {EncryptionKey: key, ApnsCertificate: &Certificate{P12: sandboxCertificate.P12, Password: "wrong"}},
+	} {
+		imported, err := ImportAppConfig(context.Background(), destination, "testString", bundle, options)
+		assert.NotNil(t, err, name)
+		assert.Empty(t, imported, name)
+	}
+	assert.Empty(t, target.requests)
+
+	bundle.Version = BundleVersion + 1
+	_, err = ImportAppConfig(context.Background(), destination, "testString", bundle, &ImportOptions{EncryptionKey: key})
+	assert.NotNil(t, err)
+
+	_, err = ImportAppConfig(context.Background(), destination, "testString", nil, nil)
+	assert.EqualError(t, err, "the bundle is empty")
+	for _, document := range []string{`null`, `{}`} {
+		var decoded *Bundle
+		require.Nil(t, json.Unmarshal([]byte(document), &decoded))
+		_, err = ImportAppConfig(context.Background(), destination, "testString", decoded, nil)
+		assert.NotNil(t, err, document)
+	}
+	assert.Empty(t, target.requests)
+}
+
+func TestExportWithoutEncryption(t *testing.T) {
+	_, pushService := newFakePushService(t, map[string]map[string]interface{}{
+		"chromeWebConf": {"apiKey": "chrome-api-key", "webSiteUrl": "https://example.com"},
+	})
+
+	bundle, err := ExportAppConfig(context.Background(), pushService, "testString", nil)
+	require.Nil(t, err)
+	assert.False(t, bundle.Encrypted)
+	assert.Equal(t, &ChromeWebSpec{ApiKey: "chrome-api-key", WebSiteURL: "https://example.com"}, bundle.ChromeWeb)
+
+	_, err = ExportAppConfig(context.Background(), pushService, "testString", []byte("short"))
+	assert.NotNil(t, err)
+}
+
+func TestSecretEncryption(t *testing.T) {
+	key, err := GenerateEncryptionKey()
+	require.Nil(t, err)
+
+	encrypted, err := encryptSecret(key, "gcm.apiKey", "api-key")
+	require.Nil(t, err)
+	decrypted, err := decryptSecret(key, "gcm.apiKey", encrypted)
+	require.Nil(t, err)
+	assert.Equal(t, "api-key", decrypted)
+
+	// A value encrypted for one field cannot be moved to another.
+	_, err = decryptSecret(key, "chromeWeb.apiKey", encrypted)
+	assert.NotNil(t, err)
+	_, err = decryptSecret(key, "gcm.apiKey", "api-key")
+	assert.NotNil(t, err)
+	_, err = decryptSecret(key, "gcm.apiKey", encryptedPrefix+"AAAA")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,565 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfig
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// Action : What Apply does to the configuration of a platform.
+type Action string
+
+// The actions of a plan.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change : A change Apply makes to the configuration of one platform of an application.
+type Change struct {
+	// Unique ID of the application using the push service.
+	ApplicationID string
+
+	// The platform, one of the pushservicev1.CredentialPlatform_* constants.
+	Platform string
+
+	Action Action
+
+	// The differences between the live and the desired configuration of an update, such as
+	// `webSiteUrl: "https://old.example.com" -> "https://example.com"`. Secrets are never shown.
+	Differences []string
+
+	platform platformSpec
+	spec     *Spec
+}
+
+// String returns the change on one line, followed by its differences indented on their own lines.
+func (change Change) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s %s: %s", change.ApplicationID, change.Platform, change.Action)
+	for _, difference := range change.Differences {
+		fmt.Fprintf(&builder, "\n  %s", difference)
+	}
+	return builder.String()
+}
+
+// Plan : The changes needed to bring the live configuration in line with a spec.
+type Plan struct {
+	Changes []Change
+}
+
+// Empty reports whether the live configuration already matches the spec.
+func (plan *Plan) Empty() bool {
+	return len(plan.Changes) == 0
+}
+
+// String returns the changes of the plan, one per line, for review before it is applied.
+func (plan *Plan) String() string {
+	if plan.Empty() {
+		return "No changes."
+	}
+	lines := make([]string, len(plan.Changes))
+	for i, change := range plan.Changes {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// platformSpec is implemented by the spec of each platform.
+type platformSpec interface {
+	// name returns one of the pushservicev1.CredentialPlatform_* constants.
+	name() string
+
+	absent() bool
+
+	// missingField returns the name of a required field which is not set, if any.
+	missingField() string
+
+	// configuredIn reports, when the settings of the application show it, whether the platform is configured.
+	configuredIn(settings *pushservicev1.AppSettingsObjResponse) (known bool, configured bool)
+
+	// fetch returns the live configuration, or nil if the platform is not configured.
+	fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error)
+
+	// differences compares the live configuration with the spec.
+	differences(live interface{}, spec *Spec) []string
+
+	save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error
+	remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error
+}
+
+// platforms returns the managed platforms of the application, in a fixed order.
+func (application *ApplicationSpec) platforms() (platforms []platformSpec) {
+	if application.Apns != nil {
+		platforms = append(platforms, application.Apns)
+	}
+	if application.Gcm != nil {
+		platforms = append(platforms, application.Gcm)
+	}
+	if application.ChromeWeb != nil {
+		platforms = append(platforms, application.ChromeWeb)
+	}
+	if application.FirefoxWeb != nil {
+		platforms = append(platforms, application.FirefoxWeb)
+	}
+	if application.SafariWeb != nil {
+		platforms = append(platforms, application.SafariWeb)
+	}
+	if application.ChromeAppExt != nil {
+		platforms = append(platforms, application.ChromeAppExt)
+	}
+	return
+}
+
+// PlanChanges retrieves the settings and the live configuration of every managed platform of the applications of spec
+// and returns the changes needed to match the spec. Nothing is modified.
+//
+// The push service does not return certificates, so a certificate is considered unchanged only when the service
+// reports when the configured certificate expires and the certificate of the spec expires at the same time. Nor does it
+// return the Safari icons, so a Safari configuration with an IconFile is always updated.
+// A certificate which cannot be read or decoded, or does not match isSandBox, is reported as a difference of its
+// platform instead of failing the plan.
+func PlanChanges(ctx context.Context, pushService *pushservicev1.PushServiceV1, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	plan := new(Plan)
+	for i := range spec.Applications {
+		application := &spec.Applications[i]
+		platforms := application.platforms()
+		if len(platforms) == 0 {
+			continue
+		}
+
+		settings, _, err := pushService.GetSettingsWithContext(ctx, pushService.NewGetSettingsOptions(application.ApplicationID))
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to retrieve the settings: %s", application.ApplicationID, err.Error())
+		}
+		for _, platform := range platforms {
+			change, err := planChange(ctx, pushService, spec, application.ApplicationID, settings, platform)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %s", application.ApplicationID, platform.name(), err.Error())
+			}
+			if change != nil {
+				plan.Changes = append(plan.Changes, *change)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// planChange returns the change needed for one platform, or nil if it already matches the spec.
+func planChange(ctx context.Context, pushService *pushservicev1.PushServiceV1, spec *Spec, applicationID string,
+	settings *pushservicev1.AppSettingsObjResponse, platform platformSpec) (*Change, error) {
+	var live interface{}
+	if known, configured := platform.configuredIn(settings); !known || configured {
+		var err error
+		live, err = platform.fetch(ctx, pushService, applicationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	change := &Change{ApplicationID: applicationID, Platform: platform.name(), platform: platform, spec: spec}
+	switch {
+	case platform.absent() && live == nil:
+		return nil, nil
+	case platform.absent():
+		change.Action = ActionDelete
+	case live == nil:
+		change.Action = ActionCreate
+	default:
+		differences := platform.differences(live, spec)
+		if len(differences) == 0 {
+			return nil, nil
+		}
+		change.Action = ActionUpdate
+		change.Differences = differences
+	}
+	return change, nil
+}
+
+// Apply makes the changes of plan, in order, and returns those which were made. It stops at the first change which
+// fails.
+func Apply(ctx context.Context, pushService *pushservicev1.PushServiceV1, plan *Plan) (applied []Change, err error) {
+	for _, change := range plan.Changes {
+		if change.Action == ActionDelete {
+			err = change.platform.remove(ctx, pushService, change.ApplicationID)
+		} else {
+			err = change.platform.save(ctx, pushService, change.ApplicationID, change.spec)
+		}
+		if err != nil {
+			return applied, fmt.Errorf("%s %s: unable to %s the configuration: %s",
+				change.ApplicationID, change.Platform, change.Action, err.Error())
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// notFound returns a nil error for a 404 response, so that fetch reports an unconfigured platform as nil.
+func notFound(response *core.DetailedResponse, err error) (bool, error) {
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
+		return true, nil
+	}
+	return false, err
+}
+
+// configuredSetting reports whether a setting of the application is present.
+func configuredSetting(settings *pushservicev1.AppSettingsObjResponse, value func(*pushservicev1.AppSettingsObjResponse) *string) (bool, bool) {
+	if settings == nil {
+		return false, false
+	}
+	return true, value(settings) != nil && *value(settings) != ""
+}
+
+// compareString adds the difference of a field which is not secret.
+func compareString(differences []string, field string, live *string, desired string) []string {
+	if live == nil || *live != desired {
+		return append(differences, fmt.Sprintf("%s: %s -> %q", field, quoteOrNone(live), desired))
+	}
+	return differences
+}
+
+// compareValue adds the difference of a field the service returns untyped.
+func compareValue(differences []string, field string, live interface{}, desired string) []string {
+	if live == nil {
+		return compareString(differences, field, nil, desired)
+	}
+	value := fmt.Sprint(live)
+	return compareString(differences, field, &value, desired)
+}
+
+// compareSecret adds the difference of a secret field without showing its values.
+func compareSecret(differences []string, field string, live *string, desired string) []string {
+	if live == nil || *live != desired {
+		return append(differences, fmt.Sprintf("%s: changed", field))
+	}
+	return differences
+}
+
+// compareCertificate adds the difference of a certificate, which is unchanged only if it expires when the configured
+// one does.
+func compareCertificate(differences []string, liveValidUntil *string, notAfter time.Time) []string {
+	if liveValidUntil == nil || *liveValidUntil == "" {
+		return append(differences, "certificate: cannot be compared with the configured certificate")
+	}
+	validUntil, err := pushservicev1.ParseCredentialExpiry(*liveValidUntil)
+	if err != nil {
+		return append(differences, "certificate: cannot be compared with the configured certificate")
+	}
+	if !validUntil.Truncate(time.Second).Equal(notAfter.Truncate(time.Second)) {
+		return append(differences, fmt.Sprintf("certificate: expiring %s -> expiring %s",
+			validUntil.UTC().Format(time.RFC3339), notAfter.UTC().Format(time.RFC3339)))
+	}
+	return differences
+}
+
+// unusableCertificate adds the difference of a certificate which cannot be read, decoded or used. Apply uploads it
+// anyway, and fails if the push service rejects it.
+func unusableCertificate(differences []string, err error) []string {
+	return append(differences, fmt.Sprintf("certificate: cannot be compared with the configured certificate: %s", err.Error()))
+}
+
+func quoteOrNone(value *string) string {
+	if value == nil {
+		return "(none)"
+	}
+	return fmt.Sprintf("%q", *value)
+}
+
+// apns
+
+func (*ApnsSpec) name() string { return pushservicev1.CredentialPlatform_Apns }
+
+func (spec *ApnsSpec) absent() bool { return spec.Absent }
+
+func (*ApnsSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return configuredSetting(settings, func(settings *pushservicev1.AppSettingsObjResponse) *string { return settings.ApnsConf })
+}
+
+func (*ApnsSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetApnsConfWithContext(ctx, pushService.NewGetApnsConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (apns *ApnsSpec) differences(live interface{}, spec *Spec) (differences []string) {
+	conf := live.(*pushservicev1.ApnsCertUploadResponse)
+	if conf.IsSandBox == nil || *conf.IsSandBox != apns.IsSandBox {
+		differences = append(differences, fmt.Sprintf("isSandBox: %v -> %v", conf.IsSandBox != nil && *conf.IsSandBox, apns.IsSandBox))
+	}
+	p12, err := ioutil.ReadFile(spec.path(apns.CertificateFile))
+	if err != nil {
+		return unusableCertificate(differences, err)
+	}
+	info, err := pushservicev1.InspectApnsCertificate(p12, apns.Password)
+	if err != nil {
+		return unusableCertificate(differences, err)
+	}
+	if err = info.Validate(apns.IsSandBox); err != nil {
+		return unusableCertificate(differences, err)
+	}
+	return compareCertificate(differences, conf.ValidUntil, info.NotAfter)
+}
+
+func (apns *ApnsSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	certificate, err := os.Open(spec.path(apns.CertificateFile))
+	if err != nil {
+		return err
+	}
+	defer certificate.Close()
+	_, _, err = pushService.SaveApnsConfWithContext(ctx,
+		pushService.NewSaveApnsConfOptions(applicationID, apns.Password, apns.IsSandBox, certificate))
+	return err
+}
+
+func (*ApnsSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteApnsConfWithContext(ctx, pushService.NewDeleteApnsConfOptions(applicationID))
+	return err
+}
+
+// gcm
+
+func (*GcmSpec) name() string { return pushservicev1.CredentialPlatform_Gcm }
+
+func (spec *GcmSpec) absent() bool { return spec.Absent }
+
+func (*GcmSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return configuredSetting(settings, func(settings *pushservicev1.AppSettingsObjResponse) *string { return settings.GcmConf })
+}
+
+func (*GcmSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetGCMConfWithContext(ctx, pushService.NewGetGCMConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (gcm *GcmSpec) differences(live interface{}, spec *Spec) (differences []string) {
+	conf := live.(*pushservicev1.GCMCredendialsModel)
+	differences = compareSecret(differences, "apiKey", conf.ApiKey, gcm.ApiKey)
+	return compareString(differences, "senderId", conf.SenderID, gcm.SenderID)
+}
+
+func (gcm *GcmSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	_, _, err := pushService.SaveGCMConfWithContext(ctx, pushService.NewSaveGCMConfOptions(applicationID, gcm.ApiKey, gcm.SenderID))
+	return err
+}
+
+func (*GcmSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteGCMConfWithContext(ctx, pushService.NewDeleteGCMConfOptions(applicationID))
+	return err
+}
+
+// chromeWeb
+
+func (*ChromeWebSpec) name() string { return pushservicev1.CredentialPlatform_ChromeWeb }
+
+func (spec *ChromeWebSpec) absent() bool { return spec.Absent }
+
+func (*ChromeWebSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return configuredSetting(settings, func(settings *pushservicev1.AppSettingsObjResponse) *string { return settings.ChromeWebConf })
+}
+
+func (*ChromeWebSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetChromeWebConfWithContext(ctx, pushService.NewGetChromeWebConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (chromeWeb *ChromeWebSpec) differences(live interface{}, spec *Spec) (differences []string) {
+	conf := live.(*pushservicev1.ChromeWebPushCredendialsModel)
+	differences = compareSecret(differences, "apiKey", conf.ApiKey, chromeWeb.ApiKey)
+	return compareString(differences, "webSiteUrl", conf.WebSiteURL, chromeWeb.WebSiteURL)
+}
+
+func (chromeWeb *ChromeWebSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	_, _, err := pushService.SaveChromeWebConfWithContext(ctx,
+		pushService.NewSaveChromeWebConfOptions(applicationID, chromeWeb.ApiKey, chromeWeb.WebSiteURL))
+	return err
+}
+
+func (*ChromeWebSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteChromeWebConfWithContext(ctx, pushService.NewDeleteChromeWebConfOptions(applicationID))
+	return err
+}
+
+// firefoxWeb
+
+func (*FirefoxWebSpec) name() string { return pushservicev1.CredentialPlatform_FirefoxWeb }
+
+func (spec *FirefoxWebSpec) absent() bool { return spec.Absent }
+
+func (*FirefoxWebSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return configuredSetting(settings, func(settings *pushservicev1.AppSettingsObjResponse) *string { return settings.FirefoxWebConf })
+}
+
+func (*FirefoxWebSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetFirefoxWebConfWithContext(ctx, pushService.NewGetFirefoxWebConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (firefoxWeb *FirefoxWebSpec) differences(live interface{}, spec *Spec) []string {
+	conf := live.(*pushservicev1.FirefoxWebPushCredendialsModel)
+	return compareString(nil, "webSiteUrl", conf.WebSiteURL, firefoxWeb.WebSiteURL)
+}
+
+func (firefoxWeb *FirefoxWebSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	_, _, err := pushService.SaveFirefoxWebConfWithContext(ctx,
+		pushService.NewSaveFirefoxWebConfOptions(applicationID, firefoxWeb.WebSiteURL))
+	return err
+}
+
+func (*FirefoxWebSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteFirefoxWebConfWithContext(ctx, pushService.NewDeleteFirefoxWebConfOptions(applicationID))
+	return err
+}
+
+// safariWeb
+
+func (*SafariWebSpec) name() string { return pushservicev1.CredentialPlatform_SafariWeb }
+
+func (spec *SafariWebSpec) absent() bool { return spec.Absent }
+
+func (*SafariWebSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return configuredSetting(settings, func(settings *pushservicev1.AppSettingsObjResponse) *string { return settings.SafariWebConf })
+}
+
+func (*SafariWebSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetSafariWebConfWithContext(ctx, pushService.NewGetSafariWebConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (safariWeb *SafariWebSpec) differences(live interface{}, spec *Spec) (differences []string) {
+	conf := live.(*pushservicev1.SafariCertUploadResponse)
+	differences = compareString(differences, "websiteName", conf.WebsiteName, safariWeb.WebsiteName)
+	differences = compareString(differences, "urlFormatString", conf.UrlFormatString, safariWeb.UrlFormatString)
+	differences = compareValue(differences, "websitePushID", conf.WebsitePushID, safariWeb.WebsitePushID)
+	differences = compareValue(differences, "webSiteUrl", conf.WebSiteURL, safariWeb.WebSiteURL)
+
+	differences = safariWeb.compareCertificate(differences, conf, spec)
+	if safariWeb.IconFile != "" {
+		// The configured icons cannot be compared with the icon file.
+		differences = append(differences, fmt.Sprintf("icons: will be uploaded from %q", safariWeb.IconFile))
+	}
+	return differences
+}
+
+func (safariWeb *SafariWebSpec) compareCertificate(differences []string, conf *pushservicev1.SafariCertUploadResponse, spec *Spec) []string {
+	p12, err := ioutil.ReadFile(spec.path(safariWeb.CertificateFile))
+	if err != nil {
+		return unusableCertificate(differences, err)
+	}
+	_, certificate, _, err := pkcs12.DecodeChain(p12, safariWeb.Password)
+	if _, ok := err.(pkcs12.NotImplementedError); ok {
+		return unusableCertificate(differences, fmt.Errorf("the format of the certificate file is not supported: %s", err.Error()))
+	}
+	if err != nil {
+		return unusableCertificate(differences, fmt.Errorf("unable to decode the certificate: %s", err.Error()))
+	}
+	var validUntil *string
+	if value, ok := conf.GetProperty("validUntil").(string); ok {
+		validUntil = &value
+	}
+	return compareCertificate(differences, validUntil, certificate.NotAfter)
+}
+
+func (safariWeb *SafariWebSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	certificate, err := os.Open(spec.path(safariWeb.CertificateFile))
+	if err != nil {
+		return err
+	}
+	defer certificate.Close()
+	saveSafariWebConfOptions := pushService.NewSaveSafariWebConfOptions(applicationID, safariWeb.Password, certificate,
+		safariWeb.WebsiteName, safariWeb.UrlFormatString, safariWeb.WebsitePushID, safariWeb.WebSiteURL)
+	if safariWeb.IconFile != "" {
+		icon, err := os.Open(spec.path(safariWeb.IconFile))
+		if err != nil {
+			return err
+		}
+		defer icon.Close()
+		if err = saveSafariWebConfOptions.GenerateIcons(icon); err != nil {
+			return err
+		}
+	}
+	_, _, err = pushService.SaveSafariWebConfWithContext(ctx, saveSafariWebConfOptions)
+	return err
+}
+
+func (*SafariWebSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteSafariWebConfWithContext(ctx, pushService.NewDeleteSafariWebConfOptions(applicationID))
+	return err
+}
+
+// chromeAppExt
+
+func (*ChromeAppExtSpec) name() string { return pushservicev1.CredentialPlatform_ChromeAppExt }
+
+func (spec *ChromeAppExtSpec) absent() bool { return spec.Absent }
+
+// configuredIn reports the setting as unknown, because the settings of an application do not include it.
+func (*ChromeAppExtSpec) configuredIn(settings *pushservicev1.AppSettingsObjResponse) (bool, bool) {
+	return false, false
+}
+
+func (*ChromeAppExtSpec) fetch(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) (interface{}, error) {
+	result, response, err := pushService.GetChromeAppExtConfWithContext(ctx, pushService.NewGetChromeAppExtConfOptions(applicationID))
+	if missing, err := notFound(response, err); missing || err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (chromeAppExt *ChromeAppExtSpec) differences(live interface{}, spec *Spec) (differences []string) {
+	conf := live.(*pushservicev1.GCMCredendialsModel)
+	differences = compareSecret(differences, "apiKey", conf.ApiKey, chromeAppExt.ApiKey)
+	return compareString(differences, "senderId", conf.SenderID, chromeAppExt.SenderID)
+}
+
+func (chromeAppExt *ChromeAppExtSpec) save(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, spec *Spec) error {
+	_, _, err := pushService.SaveChromeAppExtConfWithContext(ctx,
+		pushService.NewSaveChromeAppExtConfOptions(applicationID, chromeAppExt.ApiKey, chromeAppExt.SenderID))
+	return err
+}
+
+func (*ChromeAppExtSpec) remove(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string) error {
+	_, err := pushService.DeleteChromeAppExtConfWithContext(ctx, pushService.NewDeleteChromeAppExtConfOptions(applicationID))
+	return err
+}
@@ -0,0 +1,340 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureValidUntil is when the certificates of testdata expire.
+const fixtureValidUntil = "2126-01-01T00:00:00Z"
+
+// fakePushService keeps the platform configurations of the application testString, keyed by the last segment of their
+// path, such as gcmConf, and records the requests it receives.
+type fakePushService struct {
+	mutex    sync.Mutex
+	confs    map[string]map[string]interface{}
+	requests []string
+}
+
+func (fake *fakePushService) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.requests = append(fake.requests, req.Method+" "+req.URL.Path)
+	res.Header().Set("Content-type", "application/json")
+
+	if req.URL.Path == "/apps/testString/settings" {
+		settings := make(map[string]string)
+		for name := range fake.confs {
+			if name != "chromeAppExtConf" {
+				settings[name] = name
+			}
+		}
+		_ = json.NewEncoder(res).Encode(settings)
+		return
+	}
+
+	name := strings.TrimPrefix(req.URL.Path, "/apps/testString/settings/")
+	switch req.Method {
+	case "GET":
+		conf, ok := fake.confs[name]
+		if !ok {
+			res.WriteHeader(404)
+			_, _ = res.Write([]byte(`{"message": "Not found"}`))
+			return
+		}
+		_ = json.NewEncoder(res).Encode(conf)
+	case "PUT":
+		conf := make(map[string]interface{})
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				res.WriteHeader(400)
+				return
+			}
+			for field, values := range req.MultipartForm.Value {
+				if field != "password" {
+					conf[field] = values[0]
+				}
+			}
+			if conf["isSandBox"] != nil {
+				conf["isSandBox"] = conf["isSandBox"] == "true"
+			}
+			conf["certificate"] = "certificate.p12"
+			conf["validUntil"] = fixtureValidUntil
+			conf["icons"] = len(req.MultipartForm.File) - 1
+		} else if err := json.NewDecoder(req.Body).Decode(&conf); err != nil {
+			res.WriteHeader(400)
+			return
+		}
+		fake.confs[name] = conf
+		_ = json.NewEncoder(res).Encode(conf)
+	case "DELETE":
+		delete(fake.confs, name)
+		res.WriteHeader(204)
+	}
+}
+
+func newFakePushService(t *testing.T, confs map[string]map[string]interface{}) (*fakePushService, *pushservicev1.PushServiceV1) {
+	fake := &fakePushService{confs: confs}
+	testServer := httptest.NewServer(fake)
+	t.Cleanup(testServer.Close)
+
+	pushService, err := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return fake, pushService
+}
+
+func writeIcon(t *testing.T, dir string) {
+	var buffer bytes.Buffer
+	require.Nil(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, 512, 512))))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "icon.png"), buffer.Bytes(), 0600))
+}
+
+func newTestSpec(t *testing.T) *Spec {
+	dir, err := ioutil.TempDir("", "appconfig")
+	require.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	writeIcon(t, dir)
+	return &Spec{
+		BaseDir: dir,
+		Applications: []ApplicationSpec{{
+			ApplicationID: "testString",
+			Apns: &ApnsSpec{
+				CertificateFile: mustAbs(t, "testdata/apns_sandbox.p12"),
+				Password:        "secret",
+				IsSandBox:       true,
+			},
+			Gcm:        &GcmSpec{ApiKey: "new-api-key", SenderID: "1234"},
+			ChromeWeb:  &ChromeWebSpec{ApiKey: "chrome-api-key", WebSiteURL: "https://example.com"},
+			FirefoxWeb: &FirefoxWebSpec{WebSiteURL: "https://example.com"},
+			SafariWeb: &SafariWebSpec{
+				CertificateFile: mustAbs(t, "testdata/website_push_id.p12"),
+				Password:        "secret",
+				WebsiteName:     "Example",
+				UrlFormatString: "https://example.com/%@",
+				WebsitePushID:   "web.com.example",
+				WebSiteURL:      "https://example.com",
+				IconFile:        "icon.png",
+			},
+			ChromeAppExt: &ChromeAppExtSpec{Absent: true},
+		}},
+	}
+}
+
+func mustAbs(t *testing.T, path string) string {
+	abs, err := filepath.Abs(path)
+	require.Nil(t, err)
+	return abs
+}
+
+func TestPlanAndApply(t *testing.T) {
+	fake, pushService := newFakePushService(t, map[string]map[string]interface{}{
+		"apnsConf":         {"certificate": "certificate.p12", "isSandBox": false, "validUntil": fixtureValidUntil},
+		"gcmConf":          {"apiKey": "old-api-key", "senderId": "1234"},
+		"chromeWebConf":    {"apiKey": "chrome-api-key", "webSiteUrl": "https://example.com"},
+		"firefoxWebConf":   {"webSiteUrl": "https://old.example.com"},
+		"chromeAppExtConf": {"apiKey": "app-ext-api-key", "senderId": "5678"},
+	})
+	spec := newTestSpec(t)
+
+	plan, err := PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"testString apns: update",
+		"  isSandBox: false -> true",
+		"testString gcm: update",
+		"  apiKey: changed",
+		"testString firefoxWeb: update",
+		`  webSiteUrl: "https://old.example.com" -> "https://example.com"`,
+		"testString safariWeb: create",
+		"testString chromeAppExt: delete",
+	}, "\n"), plan.String())
+	assert.NotContains(t, plan.String(), "new-api-key")
+	for _, request := range fake.requests {
+		assert.True(t, strings.HasPrefix(request, "GET "), request)
+	}
+	assert.NotContains(t, fake.requests, "GET /apps/testString/settings/safariWebConf")
+
+	fake.requests = nil
+	applied, err := Apply(context.Background(), pushService, plan)
+	require.Nil(t, err)
+	assert.Len(t, applied, 5)
+	assert.Equal(t, []string{
+		"PUT /apps/testString/settings/apnsConf",
+		"PUT /apps/testString/settings/gcmConf",
+		"PUT /apps/testString/settings/firefoxWebConf",
+		"PUT /apps/testString/settings/safariWebConf",
+		"DELETE /apps/testString/settings/chromeAppExtConf",
+	}, fake.requests)
+	assert.Equal(t, true, fake.confs["apnsConf"]["isSandBox"])
+	assert.Equal(t, "new-api-key", fake.confs["gcmConf"]["apiKey"])
+	assert.Equal(t, "Example", fake.confs["safariWebConf"]["websiteName"])
+	assert.Equal(t, 6, fake.confs["safariWebConf"]["icons"])
+	assert.NotContains(t, fake.confs, "chromeAppExtConf")
+
+	// Only the icons, which the push service does not return, are uploaded again.
+	plan, err = PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	assert.Equal(t, "testString safariWeb: update\n  icons: will be uploaded from \"icon.png\"", plan.String())
+
+	spec.Applications[0].SafariWeb.IconFile = ""
+	plan, err = PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	assert.True(t, plan.Empty())
+	assert.Equal(t, "No changes.", plan.String())
+}
+
+func TestPlanIconChange(t *testing.T) {
+	fake, pushService := newFakePushService(t, map[string]map[string]interface{}{
+		"safariWebConf": {
+			"certificate":     "certificate.p12",
+			"websiteName":     "Example",
+			"urlFormatString": "https://example.com/%@",
+			"websitePushID":   "web.com.example",
+			"webSiteUrl":      "https://example.com",
+			"validUntil":      fixtureValidUntil,
+		},
+	})
+	spec := newTestSpec(t)
+	safariWeb := spec.Applications[0].SafariWeb
+	spec.Applications[0] = ApplicationSpec{ApplicationID: "testString", SafariWeb: safariWeb}
+
+	plan, err := PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, ActionUpdate, plan.Changes[0].Action)
+	assert.Equal(t, []string{`icons: will be uploaded from "icon.png"`}, plan.Changes[0].Differences)
+
+	fake.requests = nil
+	_, err = Apply(context.Background(), pushService, plan)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"PUT /apps/testString/settings/safariWebConf"}, fake.requests)
+	assert.Equal(t, 6, fake.confs["safariWebConf"]["icons"])
+}
+
+func TestPlanCertificateChanges(t *testing.T) {
+	_, pushService := newFakePushService(t, map[string]map[string]interface{}{
+		"apnsConf": {"certificate": "certificate.p12", "isSandBox": true, "validUntil": "2030-01-01T00:00:00Z"},
+	})
+	spec := &Spec{Applications: []ApplicationSpec{{
+		ApplicationID: "testString",
+		Apns:          &ApnsSpec{CertificateFile: "testdata/apns_sandbox.p12", Password: "secret", IsSandBox: true},
+	}}}
+
+	plan, err := PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, []string{"certificate: expiring 2030-01-01T00:00:00Z -> expiring 2126-01-01T00:00:00Z"}, plan.Changes[0].Differences)
+
+	// A production certificate in a sandbox configuration, or a wrong password, is reported in the plan, and the other
+	// platforms are still planned.
+	spec.Applications[0].Gcm = &GcmSpec{ApiKey: "api-key", SenderID: "1234"}
+	spec.Applications[0].Apns.IsSandBox = false
+	plan, err = PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, []string{
+		"isSandBox: true -> false",
+		"certificate: cannot be compared with the configured certificate: apns: the certificate for 'com.example.app' is a development certificate but isSandBox is false",
+	}, plan.Changes[0].Differences)
+	assert.Equal(t, ActionCreate, plan.Changes[1].Action)
+
+	spec.Applications[0].Apns.IsSandBox = true
+	spec.Applications[0].Apns.Password = "wrong"
+	plan, err = PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, []string{
+		"certificate: cannot be compared with the configured certificate: apns: incorrect password for the certificate",
+	}, plan.Changes[0].Differences)
+	assert.NotContains(t, plan.String(), "wrong")
+}
+
+func TestPlanUndecodableSafariCertificate(t *testing.T) {
+	_, pushService := newFakePushService(t, map[string]map[string]interface{}{
+		"safariWebConf": {
+			"certificate":     "certificate.p12",
+			"websiteName":     "Example",
+			"urlFormatString": "https://example.com/%@",
+			"websitePushID":   "web.com.example",
+			"webSiteUrl":      "https://example.com",
+			"validUntil":      fixtureValidUntil,
+		},
+		"gcmConf": {"apiKey": "old-api-key", "senderId": "1234"},
+	})
+	spec := newTestSpec(t)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(spec.BaseDir, "corrupt.p12"), []byte("not a certificate"), 0600))
+	safariWeb := spec.Applications[0].SafariWeb
+	safariWeb.CertificateFile = "corrupt.p12"
+	safariWeb.IconFile = ""
+	spec.Applications[0] = ApplicationSpec{
+		ApplicationID: "testString",
+		Gcm:           &GcmSpec{ApiKey: "new-api-key", SenderID: "1234"},
+		SafariWeb:     safariWeb,
+	}
+
+	plan, err := PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, []string{"apiKey: changed"}, plan.Changes[0].Differences)
+	assert.Equal(t, "safariWeb", plan.Changes[1].Platform)
+	assert.Equal(t, ActionUpdate, plan.Changes[1].Action)
+	require.Len(t, plan.Changes[1].Differences, 1)
+	assert.True(t, strings.HasPrefix(plan.Changes[1].Differences[0],
+		"certificate: cannot be compared with the configured certificate: unable to decode the certificate: "),
+		plan.Changes[1].Differences[0])
+}
+
+func TestApplyStopsAtFirstFailure(t *testing.T) {
+	fake, pushService := newFakePushService(t, map[string]map[string]interface{}{})
+	spec := &Spec{Applications: []ApplicationSpec{{
+		ApplicationID: "testString",
+		Apns:          &ApnsSpec{CertificateFile: "testdata/missing.p12", Password: "secret", IsSandBox: true},
+		Gcm:           &GcmSpec{ApiKey: "api-key", SenderID: "1234"},
+	}}}
+
+	plan, err := PlanChanges(context.Background(), pushService, spec)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, ActionCreate, plan.Changes[0].Action)
+
+	fake.requests = nil
+	applied, err := Apply(context.Background(), pushService, plan)
+	assert.NotNil(t, err)
+	assert.Empty(t, applied)
+	assert.Empty(t, fake.requests)
+}
@@ -0,0 +1,298 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package appconfig manages the platform configurations of push service applications declaratively. A Spec, written
+// in YAML or JSON, describes the desired APNs, GCM, Chrome, Firefox, Safari and Chrome App Extension settings of each
+// application; PlanChanges compares it with the live configuration and Apply makes only the Save and Delete calls needed
+// to reconcile them.
+//
+// A spec looks like:
+//
+//	applications:
+//	  - applicationId: ${PUSH_APP_ID}
+//	    apns:
+//	      certificateFile: certs/apns.p12
+//	      password: ${APNS_PASSWORD}
+//	      isSandBox: false
+//	    gcm:
+//	      apiKey: ${GCM_API_KEY}
+//	      senderId: "123456789"
+//	    chromeAppExt:
+//	      absent: true
+//
+// A platform which is not listed is left as it is; one marked absent is deleted.
+//
+// ExportAppConfig and ImportAppConfig copy the configurations of an application to another one as a Bundle, for
+// instance to migrate it to another push service instance.
+package appconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Spec : The desired configuration of a set of applications.
+type Spec struct {
+	// The applications to configure.
+	Applications []ApplicationSpec `json:"applications" yaml:"applications"`
+
+	// The directory relative certificate and icon files are resolved against. LoadSpecFile sets it to the directory of
+	// the spec file.
+	BaseDir string `json:"-" yaml:"-"`
+}
+
+// ApplicationSpec : The desired configuration of one application. A nil platform is not managed.
+type ApplicationSpec struct {
+	// Unique ID of the application using the push service.
+	ApplicationID string `json:"applicationId" yaml:"applicationId"`
+
+	Apns         *ApnsSpec         `json:"apns,omitempty" yaml:"apns,omitempty"`
+	Gcm          *GcmSpec          `json:"gcm,omitempty" yaml:"gcm,omitempty"`
+	ChromeWeb    *ChromeWebSpec    `json:"chromeWeb,omitempty" yaml:"chromeWeb,omitempty"`
+	FirefoxWeb   *FirefoxWebSpec   `json:"firefoxWeb,omitempty" yaml:"firefoxWeb,omitempty"`
+	SafariWeb    *SafariWebSpec    `json:"safariWeb,omitempty" yaml:"safariWeb,omitempty"`
+	ChromeAppExt *ChromeAppExtSpec `json:"chromeAppExt,omitempty" yaml:"chromeAppExt,omitempty"`
+}
+
+// ApnsSpec : The desired APNs configuration.
+type ApnsSpec struct {
+	// When true, the APNs configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// The path of the .p12 certificate.
+	CertificateFile string `json:"certificateFile,omitempty" yaml:"certificateFile,omitempty"`
+
+	// Password for the certificate.
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+
+	// Whether the certificate is for the APNs sandbox environment.
+	IsSandBox bool `json:"isSandBox" yaml:"isSandBox"`
+}
+
+// GcmSpec : The desired GCM configuration.
+type GcmSpec struct {
+	// When true, the GCM configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// An API key that gives the push service an authorized access to Google services.
+	ApiKey string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+
+	// Project Number in the Google Developers Console.
+	SenderID string `json:"senderId,omitempty" yaml:"senderId,omitempty"`
+}
+
+// ChromeWebSpec : The desired Chrome web push configuration.
+type ChromeWebSpec struct {
+	// When true, the Chrome configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// An API key that gives the push service an authorized access to Google services.
+	ApiKey string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+
+	// The URL of the WebSite / WebApp that should be permitted to subscribe to WebPush.
+	WebSiteURL string `json:"webSiteUrl,omitempty" yaml:"webSiteUrl,omitempty"`
+}
+
+// FirefoxWebSpec : The desired Firefox web push configuration.
+type FirefoxWebSpec struct {
+	// When true, the Firefox configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// The URL of the WebSite / WebApp that should be permitted to subscribe to WebPush.
+	WebSiteURL string `json:"webSiteUrl,omitempty" yaml:"webSiteUrl,omitempty"`
+}
+
+// SafariWebSpec : The desired Safari web push configuration.
+type SafariWebSpec struct {
+	// When true, the Safari configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// The path of the Website Push ID certificate (p12 format).
+	CertificateFile string `json:"certificateFile,omitempty" yaml:"certificateFile,omitempty"`
+
+	// Password for the certificate.
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+
+	// The website name. This is the heading used in Notification Center.
+	WebsiteName string `json:"websiteName,omitempty" yaml:"websiteName,omitempty"`
+
+	// The URL to go to when the notification is clicked, with %@ placeholders for the urlArgs of the notification.
+	UrlFormatString string `json:"urlFormatString,omitempty" yaml:"urlFormatString,omitempty"`
+
+	// Unique reverse-domain string for your Website Push ID, such as web.com.example.domain.
+	WebsitePushID string `json:"websitePushID,omitempty" yaml:"websitePushID,omitempty"`
+
+	// The URL of the website that should be permitted to subscribe to Safari Push Notifications.
+	WebSiteURL string `json:"webSiteUrl,omitempty" yaml:"webSiteUrl,omitempty"`
+
+	// The path of a square image of at least pushservicev1.MinSafariIconSourceSize pixels, scaled to the six sizes of
+	// the Safari iconset. When empty, the push service uses its default icons.
+	IconFile string `json:"iconFile,omitempty" yaml:"iconFile,omitempty"`
+}
+
+// ChromeAppExtSpec : The desired Chrome App Extension configuration.
+type ChromeAppExtSpec struct {
+	// When true, the Chrome App Extension configuration is deleted and the other fields are ignored.
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+
+	// An API key that gives the push service an authorized access to Google services.
+	ApiKey string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+
+	// Project Number in the Google Developers Console.
+	SenderID string `json:"senderId,omitempty" yaml:"senderId,omitempty"`
+}
+
+// variablePattern matches the ${NAME} references to environment variables in a spec.
+var variablePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// LoadSpec parses a spec in YAML or JSON. References of the form ${NAME} in string values are replaced by the value of
+// the environment variable NAME, so that secrets and per-environment values need not be stored in the spec; an unset
+// variable is an error. The references are replaced after parsing, so a value may hold any character. Unknown fields
+// are rejected, and the spec is validated.
+func LoadSpec(data []byte) (*Spec, error) {
+	spec := new(Spec)
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("unable to parse the spec: %s", err.Error())
+	}
+
+	var missing []string
+	expandVariables(reflect.ValueOf(spec), &missing)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the spec references unset environment variables %v", missing)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// expandVariables replaces the ${NAME} references in the strings value holds, adding the names of the unset variables
+// to missing.
+func expandVariables(value reflect.Value, missing *[]string) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			expandVariables(value.Elem(), missing)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath == "" {
+				expandVariables(value.Field(i), missing)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			expandVariables(value.Index(i), missing)
+		}
+	case reflect.String:
+		value.SetString(variablePattern.ReplaceAllStringFunc(value.String(), func(reference string) string {
+			name := variablePattern.FindStringSubmatch(reference)[1]
+			variable, ok := os.LookupEnv(name)
+			if !ok {
+				*missing = append(*missing, name)
+			}
+			return variable
+		}))
+	}
+}
+
+// LoadSpecFile reads and parses a spec file, resolving relative file paths against its directory.
+func LoadSpecFile(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := LoadSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	spec.BaseDir = filepath.Dir(path)
+	return spec, nil
+}
+
+// Validate checks that every application has a unique ID and that every platform which is not absent has its
+// required fields.
+func (spec *Spec) Validate() error {
+	seen := make(map[string]bool)
+	for i, application := range spec.Applications {
+		if application.ApplicationID == "" {
+			return fmt.Errorf("applications[%d]: applicationId is required", i)
+		}
+		if seen[application.ApplicationID] {
+			return fmt.Errorf("applications[%d]: duplicate applicationId '%s'", i, application.ApplicationID)
+		}
+		seen[application.ApplicationID] = true
+		for _, platform := range application.platforms() {
+			if platform.absent() {
+				continue
+			}
+			if field := platform.missingField(); field != "" {
+				return fmt.Errorf("applications[%d]: %s: %s is required", i, platform.name(), field)
+			}
+		}
+	}
+	return nil
+}
+
+// path resolves a file path of the spec against BaseDir.
+func (spec *Spec) path(file string) string {
+	if filepath.IsAbs(file) || spec.BaseDir == "" {
+		return file
+	}
+	return filepath.Join(spec.BaseDir, file)
+}
+
+// firstEmpty returns the name of the first empty value, given as name, value pairs.
+func firstEmpty(fields ...string) string {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			return fields[i]
+		}
+	}
+	return ""
+}
+
+func (spec *ApnsSpec) missingField() string {
+	return firstEmpty("certificateFile", spec.CertificateFile, "password", spec.Password)
+}
+
+func (spec *GcmSpec) missingField() string {
+	return firstEmpty("apiKey", spec.ApiKey, "senderId", spec.SenderID)
+}
+
+func (spec *ChromeWebSpec) missingField() string {
+	return firstEmpty("apiKey", spec.ApiKey, "webSiteUrl", spec.WebSiteURL)
+}
+
+func (spec *FirefoxWebSpec) missingField() string {
+	return firstEmpty("webSiteUrl", spec.WebSiteURL)
+}
+
+func (spec *SafariWebSpec) missingField() string {
+	return firstEmpty("certificateFile", spec.CertificateFile, "password", spec.Password, "websiteName", spec.WebsiteName,
+		"urlFormatString", spec.UrlFormatString, "websitePushID", spec.WebsitePushID, "webSiteUrl", spec.WebSiteURL)
+}
+
+func (spec *ChromeAppExtSpec) missingField() string {
+	return firstEmpty("apiKey", spec.ApiKey, "senderId", spec.SenderID)
+}
@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpecYAML = `
+applications:
+  - applicationId: ${APPCONFIG_TEST_APP_ID}
+    apns:
+      certificateFile: apns_sandbox.p12
+      password: ${APPCONFIG_TEST_PASSWORD}
+      isSandBox: true
+    gcm:
+      apiKey: gcm-api-key
+      senderId: "1234"
+    chromeAppExt:
+      absent: true
+`
+
+func setTestEnvironment(t *testing.T) {
+	os.Setenv("APPCONFIG_TEST_APP_ID", "testString")
+	os.Setenv("APPCONFIG_TEST_PASSWORD", "secret")
+	t.Cleanup(func() {
+		os.Unsetenv("APPCONFIG_TEST_APP_ID")
+		os.Unsetenv("APPCONFIG_TEST_PASSWORD")
+	})
+}
+
+func TestLoadSpec(t *testing.T) {
+	setTestEnvironment(t)
+
+	spec, err := LoadSpec([]byte(testSpecYAML))
+	require.Nil(t, err)
+	require.Len(t, spec.Applications, 1)
+	application := spec.Applications[0]
+	assert.Equal(t, "testString", application.ApplicationID)
+	assert.Equal(t, &ApnsSpec{CertificateFile: "apns_sandbox.p12", Password: "secret", IsSandBox: true}, application.Apns)
+	assert.Equal(t, &GcmSpec{ApiKey: "gcm-api-key", SenderID: "1234"}, application.Gcm)
+	assert.Equal(t, &ChromeAppExtSpec{Absent: true}, application.ChromeAppExt)
+	assert.Nil(t, application.ChromeWeb)
+	assert.Len(t, application.platforms(), 3)
+}
+
+func TestLoadSpecJSON(t *testing.T) {
+	spec, err := LoadSpec([]byte(`{"applications": [{"applicationId": "testString", "firefoxWeb": {"webSiteUrl": "https://example.com"}}]}`))
+	require.Nil(t, err)
+	assert.Equal(t, &FirefoxWebSpec{WebSiteURL: "https://example.com"}, spec.Applications[0].FirefoxWeb)
+}
+
+func TestLoadSpecSecretCharacters(t *testing.T) {
+	setTestEnvironment(t)
+	for _, secret := range []string{"pass #word", "key: value", `"quoted" 'secret'`, "*alias", "&anchor", "!tag", "two\nlines"} {
+		os.Setenv("APPCONFIG_TEST_PASSWORD", secret)
+
+		spec, err := LoadSpec([]byte(testSpecYAML))
+		require.Nil(t, err, secret)
+		assert.Equal(t, secret, spec.Applications[0].Apns.Password)
+		assert.Equal(t, "testString", spec.Applications[0].ApplicationID)
+	}
+
+	os.Setenv("APPCONFIG_TEST_PASSWORD", "key: value")
+	spec, err := LoadSpec([]byte(`{"applications": [{"applicationId": "testString", "gcm": {"apiKey": "${APPCONFIG_TEST_PASSWORD}", "senderId": "1"}}]}`))
+	require.Nil(t, err)
+	assert.Equal(t, "key: value", spec.Applications[0].Gcm.ApiKey)
+}
+
+func TestLoadSpecErrors(t *testing.T) {
+	for name, document := range map[string]string{
+		"unset variable":     `applications: [{applicationId: "${APPCONFIG_TEST_UNSET}"}]`,
+		"unknown field":      `applications: [{applicationId: testString, gcm: {apikey: key, senderId: "1"}}]`,
+		"missing id":         `applications: [{gcm: {apiKey: key, senderId: "1"}}]`,
+		"duplicate id":       `applications: [{applicationId: testString}, {applicationId: testString}]`,
+		"missing field":      `applications: [{applicationId: testString, gcm: {apiKey: key}}]`,
+		"missing safari url": `applications: [{applicationId: testString, safariWeb: {certificateFile: a.p12, password: p, websiteName: n, urlFormatString: u, websitePushID: w}}]`,
+		"not YAML":           `applications: [`,
+	} {
+		_, err := LoadSpec([]byte(document))
+		assert.NotNil(t, err, name)
+	}
+
+	// Absent platforms need no other field.
+	_, err := LoadSpec([]byte(`applications: [{applicationId: testString, safariWeb: {absent: true}}]`))
+	assert.Nil(t, err)
+}
+
+func TestLoadSpecFile(t *testing.T) {
+	setTestEnvironment(t)
+	dir, err := ioutil.TempDir("", "appconfig")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "push.yaml")
+	require.Nil(t, ioutil.WriteFile(path, []byte(testSpecYAML), 0600))
+
+	spec, err := LoadSpecFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, dir, spec.BaseDir)
+	assert.Equal(t, filepath.Join(dir, "apns_sandbox.p12"), spec.path("apns_sandbox.p12"))
+	assert.Equal(t, "/certs/apns.p12", spec.path("/certs/apns.p12"))
+
+	_, err = LoadSpecFile(filepath.Join(dir, "missing.yaml"))
+	assert.NotNil(t, err)
+}
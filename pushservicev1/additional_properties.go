@@ -0,0 +1,31 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// unmarshalAdditionalProperty decodes the value of a property that is not part of a model. Numbers are kept as
+// json.Number so that they are marshaled again exactly as they were received.
+func unmarshalAdditionalProperty(raw json.RawMessage) (value interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	err = decoder.Decode(&value)
+	return
+}
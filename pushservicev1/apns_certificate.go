@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+var (
+	// oidApnsDevelopment marks a certificate which may be used with the APNs sandbox environment.
+	oidApnsDevelopment = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 3, 1}
+
+	// oidApnsProduction marks a certificate which may be used with the APNs production environment.
+	oidApnsProduction = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 3, 2}
+
+	// oidUserID is the subject attribute in which Apple stores the bundle ID of the application.
+	oidUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+)
+
+// ApnsCertificateInfo : The details of an Apple Push certificate read from a .p12 file.
+type ApnsCertificateInfo struct {
+	// The topic of the certificate, which is the bundle ID of the application.
+	Topic string
+
+	// The common name of the certificate subject.
+	CommonName string
+
+	// Whether the certificate can be used with the APNs sandbox environment.
+	Sandbox bool
+
+	// Whether the certificate can be used with the APNs production environment.
+	Production bool
+
+	// The time from which the certificate is valid.
+	NotBefore time.Time
+
+	// The time at which the certificate expires.
+	NotAfter time.Time
+}
+
+// InspectApnsCertificate decodes a .p12 file with its password and returns the details of the Apple Push certificate it
+// contains. An error is returned if the password is wrong, if the file is not a .p12 file or uses an unsupported
+// encryption, or if it holds no Apple Push certificate or no private key. The file may hold intermediate certificates
+// besides the Apple Push certificate.
+func InspectApnsCertificate(p12 []byte, password string) (info *ApnsCertificateInfo, err error) {
+	privateKey, certificate, caCertificates, err := pkcs12.DecodeChain(p12, password)
+	if err == pkcs12.ErrIncorrectPassword {
+		return nil, fmt.Errorf("apns: incorrect password for the certificate")
+	}
+	if _, ok := err.(pkcs12.NotImplementedError); ok {
+		return nil, fmt.Errorf("apns: the format of the certificate file is not supported: %s", err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("apns: unable to decode the certificate: %s", err.Error())
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("apns: the file does not contain the private key of the certificate")
+	}
+
+	for _, candidate := range append([]*x509.Certificate{certificate}, caCertificates...) {
+		if info = newApnsCertificateInfo(candidate); info != nil {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("apns: the file does not contain an Apple Push certificate")
+}
+
+// newApnsCertificateInfo returns the details of certificate, or nil if it is not an Apple Push certificate.
+func newApnsCertificateInfo(certificate *x509.Certificate) *ApnsCertificateInfo {
+	info := &ApnsCertificateInfo{
+		CommonName: certificate.Subject.CommonName,
+		NotBefore:  certificate.NotBefore,
+		NotAfter:   certificate.NotAfter,
+	}
+	for _, extension := range certificate.Extensions {
+		if extension.Id.Equal(oidApnsDevelopment) {
+			info.Sandbox = true
+		} else if extension.Id.Equal(oidApnsProduction) {
+			info.Production = true
+		}
+	}
+	if !info.Sandbox && !info.Production {
+		return nil
+	}
+	for _, name := range certificate.Subject.Names {
+		if topic, ok := name.Value.(string); ok && name.Type.Equal(oidUserID) {
+			info.Topic = topic
+		}
+	}
+	return info
+}
+
+// Validate checks that the certificate is currently valid and can be used with the environment selected by isSandBox.
+func (info *ApnsCertificateInfo) Validate(isSandBox bool) error {
+	now := time.Now()
+	if now.Before(info.NotBefore) {
+		return fmt.Errorf("apns: the certificate for '%s' is not valid before %s", info.Topic, info.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(info.NotAfter) {
+		return fmt.Errorf("apns: the certificate for '%s' expired on %s", info.Topic, info.NotAfter.Format(time.RFC3339))
+	}
+	if isSandBox && !info.Sandbox {
+		return fmt.Errorf("apns: the certificate for '%s' is a production certificate but isSandBox is true", info.Topic)
+	}
+	if !isSandBox && !info.Production {
+		return fmt.Errorf("apns: the certificate for '%s' is a development certificate but isSandBox is false", info.Topic)
+	}
+	return nil
+}
+
+// inspectApnsCertificateUpload reads and inspects the certificate of saveApnsConfOptions, and returns a reader over the
+// same bytes to upload in its place. Its errors tell how to skip the inspection, for certificates it cannot read.
+func inspectApnsCertificateUpload(saveApnsConfOptions *SaveApnsConfOptions) (io.ReadCloser, error) {
+	p12, err := ioutil.ReadAll(saveApnsConfOptions.Certificate)
+	saveApnsConfOptions.Certificate.Close()
+	if err != nil {
+		return nil, fmt.Errorf("apns: unable to read the certificate: %s", err.Error())
+	}
+
+	info, err := InspectApnsCertificate(p12, *saveApnsConfOptions.Password)
+	if err == nil {
+		err = info.Validate(*saveApnsConfOptions.IsSandBox)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w (set SaveApnsConfOptions.Force to upload the certificate without inspecting it)", err)
+	}
+	return bytesFile{bytes.NewReader(p12)}, nil
+}
+
+// bytesFile is a file read into memory. It is seekable, so that it is uploaded with a Content-Length.
+type bytesFile struct {
+	*bytes.Reader
+}
+
+func (bytesFile) Close() error {
+	return nil
+}
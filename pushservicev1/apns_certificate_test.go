@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// newModernApnsP12 returns a sandbox Apple Push certificate for com.example.app, issued by an intermediate certificate,
+// in a .p12 file encrypted with PBES2 and AES, as current versions of Keychain Access and OpenSSL write them.
+func newModernApnsP12(password string) []byte {
+	newCertificate := func(template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		Expect(err).To(BeNil())
+		certificate, err := x509.ParseCertificate(der)
+		Expect(err).To(BeNil())
+		return certificate, key
+	}
+	authority := func(serial int64, name string) *x509.Certificate {
+		return &x509.Certificate{SerialNumber: big.NewInt(serial), Subject: pkix.Name{CommonName: name},
+			IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}
+	}
+	root, rootKey := newCertificate(authority(1, "Root"), nil, nil)
+	intermediate, intermediateKey := newCertificate(authority(2, "Intermediate"), root, rootKey)
+	leaf, leafKey := newCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject: pkix.Name{
+			CommonName: "Apple Development IOS Push Services: com.example.app",
+			ExtraNames: []pkix.AttributeTypeAndValue{{Type: asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}, Value: "com.example.app"}},
+		},
+		ExtraExtensions: []pkix.Extension{{Id: asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 3, 1}, Value: []byte{5, 0}}},
+	}, intermediate, intermediateKey)
+
+	p12, err := pkcs12.Modern.Encode(leafKey, leaf, []*x509.Certificate{intermediate}, password)
+	Expect(err).To(BeNil())
+	return p12
+}
+
+var _ = Describe(`APNs certificate inspection`, func() {
+	// The fixtures hold self-signed certificates for com.example.app, protected with the password "secret".
+	readFixture := func(name string) []byte {
+		p12, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		Expect(err).To(BeNil())
+		return p12
+	}
+
+	Describe(`InspectApnsCertificate(p12 []byte, password string)`, func() {
+		It(`Reads the topic, environment and validity of a sandbox certificate`, func() {
+			info, err := pushservicev1.InspectApnsCertificate(readFixture("apns_sandbox.p12"), "secret")
+			Expect(err).To(BeNil())
+			Expect(info.Topic).To(Equal("com.example.app"))
+			Expect(info.CommonName).To(Equal("Apple Development IOS Push Services: com.example.app"))
+			Expect(info.Sandbox).To(BeTrue())
+			Expect(info.Production).To(BeFalse())
+			Expect(info.NotAfter.After(info.NotBefore)).To(BeTrue())
+			Expect(info.Validate(true)).To(BeNil())
+			Expect(info.Validate(false)).ToNot(BeNil())
+		})
+		It(`Reads the environment of production and universal certificates`, func() {
+			info, err := pushservicev1.InspectApnsCertificate(readFixture("apns_production.p12"), "secret")
+			Expect(err).To(BeNil())
+			Expect(info.Sandbox).To(BeFalse())
+			Expect(info.Production).To(BeTrue())
+			Expect(info.Validate(false)).To(BeNil())
+			Expect(info.Validate(true)).ToNot(BeNil())
+
+			info, err = pushservicev1.InspectApnsCertificate(readFixture("apns_universal.p12"), "secret")
+			Expect(err).To(BeNil())
+			Expect(info.Topic).To(Equal("com.example.app"))
+			Expect(info.Validate(true)).To(BeNil())
+			Expect(info.Validate(false)).To(BeNil())
+		})
+		It(`Rejects a wrong password, a non APNs certificate and invalid data`, func() {
+			_, err := pushservicev1.InspectApnsCertificate(readFixture("apns_sandbox.p12"), "wrong")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("incorrect password"))
+
+			_, err = pushservicev1.InspectApnsCertificate(readFixture("not_apns.p12"), "secret")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("Apple Push certificate"))
+
+			_, err = pushservicev1.InspectApnsCertificate([]byte("This is a mock file."), "secret")
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Reads a PBES2 encrypted file holding an intermediate certificate`, func() {
+			info, err := pushservicev1.InspectApnsCertificate(newModernApnsP12("secret"), "secret")
+			Expect(err).To(BeNil())
+			Expect(info.Topic).To(Equal("com.example.app"))
+			Expect(info.Validate(true)).To(BeNil())
+		})
+		It(`Rejects an expired certificate`, func() {
+			info, err := pushservicev1.InspectApnsCertificate(readFixture("apns_expired.p12"), "secret")
+			Expect(err).To(BeNil())
+			err = info.Validate(true)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("expired"))
+		})
+	})
+
+	Describe(`SaveApnsConf(saveApnsConfOptions *SaveApnsConfOptions) - certificate inspection`, func() {
+		saveApnsConfPath := "/apps/testString/settings/apnsConf"
+		var testServer *httptest.Server
+		var uploaded []byte
+		BeforeEach(func() {
+			uploaded = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal(saveApnsConfPath))
+				Expect(req.Method).To(Equal("PUT"))
+				file, _, err := req.FormFile("certificate")
+				Expect(err).To(BeNil())
+				uploaded, err = ioutil.ReadAll(file)
+				Expect(err).To(BeNil())
+
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"certificate": "Certificate", "isSandBox": true}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newService := func() *pushservicev1.PushServiceV1 {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			return pushServiceService
+		}
+		It(`Invoke SaveApnsConf with a certificate matching IsSandBox`, func() {
+			pushServiceService := newService()
+			p12 := readFixture("apns_sandbox.p12")
+
+			result, response, operationErr := pushServiceService.SaveApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "secret", true, ioutil.NopCloser(bytes.NewReader(p12))))
+			Expect(operationErr).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(uploaded).To(Equal(p12))
+
+			p12 = newModernApnsP12("secret")
+			_, _, operationErr = pushServiceService.SaveApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "secret", true, ioutil.NopCloser(bytes.NewReader(p12))))
+			Expect(operationErr).To(BeNil())
+			Expect(uploaded).To(Equal(p12))
+		})
+		It(`Invoke SaveApnsConf with a mismatched or unreadable certificate`, func() {
+			pushServiceService := newService()
+
+			result, response, operationErr := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+				"testString", "secret", false, ioutil.NopCloser(bytes.NewReader(readFixture("apns_sandbox.p12")))))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+
+			Expect(operationErr.Error()).To(ContainSubstring("Force"))
+
+			_, _, operationErr = pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+				"testString", "wrong", true, ioutil.NopCloser(bytes.NewReader(readFixture("apns_sandbox.p12")))))
+			Expect(operationErr).ToNot(BeNil())
+
+			_, _, operationErr = pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+				"testString", "secret", true, CreateMockReader("This is a mock file.")))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("Force"))
+			Expect(uploaded).To(BeNil())
+		})
+		It(`Invoke SaveApnsConf with Force set`, func() {
+			pushServiceService := newService()
+
+			saveApnsConfOptionsModel := pushServiceService.NewSaveApnsConfOptions(
+				"testString", "secret", false, CreateMockReader("This is a mock file.")).SetForce(true)
+			_, response, operationErr := pushServiceService.SaveApnsConf(saveApnsConfOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(string(uploaded)).To(Equal("This is a mock file."))
+		})
+	})
+})
@@ -0,0 +1,574 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AppClient : The operations of PushServiceV1, bound to one application. Each operation takes the options of the
+// PushServiceV1 operation and fills in their ApplicationID, and their AppSecret or ClientSecret and AcceptLanguage
+// unless they are set; the options of the caller are not modified. The options may be nil when the operation needs
+// nothing but the application ID:
+//
+//	appClient, err := pushService.NewAppClient("my-app-id")
+//	settings, _, err := appClient.GetSettings(nil)
+//	result, _, err := appClient.SendMessage(appClient.NewSendMessageOptions(message))
+//
+// Options which set another ApplicationID are rejected. An AppClient is safe for concurrent use as long as its fields
+// are not changed.
+type AppClient struct {
+	// The push service the operations are sent to.
+	PushService *PushServiceV1
+
+	// Unique ID of the application.
+	ApplicationID string
+
+	// The app secret sent with the operations which take one [optional].
+	AppSecret string
+
+	// The client secret sent with the operations which take one: GetWebpushServerKey, GetGcmConfPublic and
+	// GetChromeAppExtConfPublic [optional].
+	ClientSecret string
+
+	// The preferred language to use for error messages [optional].
+	AcceptLanguage string
+}
+
+// NewAppClient : Instantiate AppClient for the application with the given ID.
+func (pushService *PushServiceV1) NewAppClient(applicationID string) (*AppClient, error) {
+	if applicationID == "" {
+		return nil, fmt.Errorf("applicationID must not be empty")
+	}
+	return &AppClient{PushService: pushService, ApplicationID: applicationID}, nil
+}
+
+// SetAppSecret : Allow user to set AppSecret
+func (client *AppClient) SetAppSecret(appSecret string) *AppClient {
+	client.AppSecret = appSecret
+	return client
+}
+
+// SetClientSecret : Allow user to set ClientSecret
+func (client *AppClient) SetClientSecret(clientSecret string) *AppClient {
+	client.ClientSecret = clientSecret
+	return client
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (client *AppClient) SetAcceptLanguage(acceptLanguage string) *AppClient {
+	client.AcceptLanguage = acceptLanguage
+	return client
+}
+
+// bind fills in the application ID, secrets and language of the options of an operation. A nil pointer stands for a
+// field the options do not have.
+func (client *AppClient) bind(applicationID **string, appSecret **string, clientSecret **string, acceptLanguage **string) error {
+	if *applicationID != nil && **applicationID != client.ApplicationID {
+		return fmt.Errorf("the options are for application '%s', but the client is bound to application '%s'",
+			**applicationID, client.ApplicationID)
+	}
+	*applicationID = core.StringPtr(client.ApplicationID)
+	bindOptional(appSecret, client.AppSecret)
+	bindOptional(clientSecret, client.ClientSecret)
+	bindOptional(acceptLanguage, client.AcceptLanguage)
+	return nil
+}
+
+// bindOptional sets an optional field of options to value, unless the field is missing, already set, or value is
+// empty.
+func bindOptional(field **string, value string) {
+	if field != nil && *field == nil && value != "" {
+		*field = core.StringPtr(value)
+	}
+}
+
+// NewSaveApnsConfOptions : Instantiate SaveApnsConfOptions for the application of the client
+func (client *AppClient) NewSaveApnsConfOptions(password string, isSandBox bool, certificate io.ReadCloser) *SaveApnsConfOptions {
+	return client.PushService.NewSaveApnsConfOptions(client.ApplicationID, password, isSandBox, certificate)
+}
+
+// NewSaveGCMConfOptions : Instantiate SaveGCMConfOptions for the application of the client
+func (client *AppClient) NewSaveGCMConfOptions(apiKey string, senderID string) *SaveGCMConfOptions {
+	return client.PushService.NewSaveGCMConfOptions(client.ApplicationID, apiKey, senderID)
+}
+
+// NewSaveSafariWebConfOptions : Instantiate SaveSafariWebConfOptions for the application of the client
+func (client *AppClient) NewSaveSafariWebConfOptions(password string, certificate io.ReadCloser, websiteName string, urlFormatString string, websitePushID string, webSiteURL string) *SaveSafariWebConfOptions {
+	return client.PushService.NewSaveSafariWebConfOptions(client.ApplicationID, password, certificate, websiteName, urlFormatString, websitePushID, webSiteURL)
+}
+
+// NewSaveChromeWebConfOptions : Instantiate SaveChromeWebConfOptions for the application of the client
+func (client *AppClient) NewSaveChromeWebConfOptions(apiKey string, webSiteURL string) *SaveChromeWebConfOptions {
+	return client.PushService.NewSaveChromeWebConfOptions(client.ApplicationID, apiKey, webSiteURL)
+}
+
+// NewSaveFirefoxWebConfOptions : Instantiate SaveFirefoxWebConfOptions for the application of the client
+func (client *AppClient) NewSaveFirefoxWebConfOptions(webSiteURL string) *SaveFirefoxWebConfOptions {
+	return client.PushService.NewSaveFirefoxWebConfOptions(client.ApplicationID, webSiteURL)
+}
+
+// NewSaveChromeAppExtConfOptions : Instantiate SaveChromeAppExtConfOptions for the application of the client
+func (client *AppClient) NewSaveChromeAppExtConfOptions(apiKey string, senderID string) *SaveChromeAppExtConfOptions {
+	return client.PushService.NewSaveChromeAppExtConfOptions(client.ApplicationID, apiKey, senderID)
+}
+
+// NewSendMessageOptions : Instantiate SendMessageOptions for the application of the client
+func (client *AppClient) NewSendMessageOptions(message *Message) *SendMessageOptions {
+	return client.PushService.NewSendMessageOptions(client.ApplicationID, message)
+}
+
+// NewSendMessagesInBulkOptions : Instantiate SendMessagesInBulkOptions for the application of the client
+func (client *AppClient) NewSendMessagesInBulkOptions(body []SendMessageBody) *SendMessagesInBulkOptions {
+	return client.PushService.NewSendMessagesInBulkOptions(client.ApplicationID, body)
+}
+
+// GetSettings : Retrieve application settings
+// See PushServiceV1.GetSettings.
+func (client *AppClient) GetSettings(getSettingsOptions *GetSettingsOptions) (result *AppSettingsObjResponse, response *core.DetailedResponse, err error) {
+	return client.GetSettingsWithContext(context.Background(), getSettingsOptions)
+}
+
+// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+func (client *AppClient) GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *AppSettingsObjResponse, response *core.DetailedResponse, err error) {
+	options := new(GetSettingsOptions)
+	if getSettingsOptions != nil {
+		*options = *getSettingsOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetSettingsWithContext(ctx, options)
+}
+
+// GetApnsConf : Get the APNS settings
+// See PushServiceV1.GetApnsConf.
+func (client *AppClient) GetApnsConf(getApnsConfOptions *GetApnsConfOptions) (result *ApnsCertUploadResponse, response *core.DetailedResponse, err error) {
+	return client.GetApnsConfWithContext(context.Background(), getApnsConfOptions)
+}
+
+// GetApnsConfWithContext is an alternate form of the GetApnsConf method which supports a Context parameter
+func (client *AppClient) GetApnsConfWithContext(ctx context.Context, getApnsConfOptions *GetApnsConfOptions) (result *ApnsCertUploadResponse, response *core.DetailedResponse, err error) {
+	options := new(GetApnsConfOptions)
+	if getApnsConfOptions != nil {
+		*options = *getApnsConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetApnsConfWithContext(ctx, options)
+}
+
+// SaveApnsConf : Updates APNS settings
+// See PushServiceV1.SaveApnsConf.
+func (client *AppClient) SaveApnsConf(saveApnsConfOptions *SaveApnsConfOptions) (result *ApnsCertUploadResponse, response *core.DetailedResponse, err error) {
+	return client.SaveApnsConfWithContext(context.Background(), saveApnsConfOptions)
+}
+
+// SaveApnsConfWithContext is an alternate form of the SaveApnsConf method which supports a Context parameter
+func (client *AppClient) SaveApnsConfWithContext(ctx context.Context, saveApnsConfOptions *SaveApnsConfOptions) (result *ApnsCertUploadResponse, response *core.DetailedResponse, err error) {
+	options := new(SaveApnsConfOptions)
+	if saveApnsConfOptions != nil {
+		*options = *saveApnsConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveApnsConfWithContext(ctx, options)
+}
+
+// DeleteApnsConf : Delete APNS settings
+// See PushServiceV1.DeleteApnsConf.
+func (client *AppClient) DeleteApnsConf(deleteApnsConfOptions *DeleteApnsConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteApnsConfWithContext(context.Background(), deleteApnsConfOptions)
+}
+
+// DeleteApnsConfWithContext is an alternate form of the DeleteApnsConf method which supports a Context parameter
+func (client *AppClient) DeleteApnsConfWithContext(ctx context.Context, deleteApnsConfOptions *DeleteApnsConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteApnsConfOptions)
+	if deleteApnsConfOptions != nil {
+		*options = *deleteApnsConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteApnsConfWithContext(ctx, options)
+}
+
+// GetGCMConf : Get the GCM settings
+// See PushServiceV1.GetGCMConf.
+func (client *AppClient) GetGCMConf(getGCMConfOptions *GetGCMConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.GetGCMConfWithContext(context.Background(), getGCMConfOptions)
+}
+
+// GetGCMConfWithContext is an alternate form of the GetGCMConf method which supports a Context parameter
+func (client *AppClient) GetGCMConfWithContext(ctx context.Context, getGCMConfOptions *GetGCMConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(GetGCMConfOptions)
+	if getGCMConfOptions != nil {
+		*options = *getGCMConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetGCMConfWithContext(ctx, options)
+}
+
+// SaveGCMConf : Updates GCM settings
+// See PushServiceV1.SaveGCMConf.
+func (client *AppClient) SaveGCMConf(saveGCMConfOptions *SaveGCMConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.SaveGCMConfWithContext(context.Background(), saveGCMConfOptions)
+}
+
+// SaveGCMConfWithContext is an alternate form of the SaveGCMConf method which supports a Context parameter
+func (client *AppClient) SaveGCMConfWithContext(ctx context.Context, saveGCMConfOptions *SaveGCMConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(SaveGCMConfOptions)
+	if saveGCMConfOptions != nil {
+		*options = *saveGCMConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveGCMConfWithContext(ctx, options)
+}
+
+// DeleteGCMConf : Delete GCM settings
+// See PushServiceV1.DeleteGCMConf.
+func (client *AppClient) DeleteGCMConf(deleteGCMConfOptions *DeleteGCMConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteGCMConfWithContext(context.Background(), deleteGCMConfOptions)
+}
+
+// DeleteGCMConfWithContext is an alternate form of the DeleteGCMConf method which supports a Context parameter
+func (client *AppClient) DeleteGCMConfWithContext(ctx context.Context, deleteGCMConfOptions *DeleteGCMConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteGCMConfOptions)
+	if deleteGCMConfOptions != nil {
+		*options = *deleteGCMConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteGCMConfWithContext(ctx, options)
+}
+
+// GetWebpushServerKey : Get the Web Push Server Key
+// See PushServiceV1.GetWebpushServerKey.
+func (client *AppClient) GetWebpushServerKey(getWebpushServerKeyOptions *GetWebpushServerKeyOptions) (result *ApplicationServerKeyModel, response *core.DetailedResponse, err error) {
+	return client.GetWebpushServerKeyWithContext(context.Background(), getWebpushServerKeyOptions)
+}
+
+// GetWebpushServerKeyWithContext is an alternate form of the GetWebpushServerKey method which supports a Context parameter
+func (client *AppClient) GetWebpushServerKeyWithContext(ctx context.Context, getWebpushServerKeyOptions *GetWebpushServerKeyOptions) (result *ApplicationServerKeyModel, response *core.DetailedResponse, err error) {
+	options := new(GetWebpushServerKeyOptions)
+	if getWebpushServerKeyOptions != nil {
+		*options = *getWebpushServerKeyOptions
+	}
+	if err = client.bind(&options.ApplicationID, nil, &options.ClientSecret, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetWebpushServerKeyWithContext(ctx, options)
+}
+
+// GetSafariWebConf : Get the Safari Push Notifications settings
+// See PushServiceV1.GetSafariWebConf.
+func (client *AppClient) GetSafariWebConf(getSafariWebConfOptions *GetSafariWebConfOptions) (result *SafariCertUploadResponse, response *core.DetailedResponse, err error) {
+	return client.GetSafariWebConfWithContext(context.Background(), getSafariWebConfOptions)
+}
+
+// GetSafariWebConfWithContext is an alternate form of the GetSafariWebConf method which supports a Context parameter
+func (client *AppClient) GetSafariWebConfWithContext(ctx context.Context, getSafariWebConfOptions *GetSafariWebConfOptions) (result *SafariCertUploadResponse, response *core.DetailedResponse, err error) {
+	options := new(GetSafariWebConfOptions)
+	if getSafariWebConfOptions != nil {
+		*options = *getSafariWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetSafariWebConfWithContext(ctx, options)
+}
+
+// SaveSafariWebConf : Updates Safari Push Notifications settings
+// See PushServiceV1.SaveSafariWebConf.
+func (client *AppClient) SaveSafariWebConf(saveSafariWebConfOptions *SaveSafariWebConfOptions) (result *SafariCertUploadResponse, response *core.DetailedResponse, err error) {
+	return client.SaveSafariWebConfWithContext(context.Background(), saveSafariWebConfOptions)
+}
+
+// SaveSafariWebConfWithContext is an alternate form of the SaveSafariWebConf method which supports a Context parameter
+func (client *AppClient) SaveSafariWebConfWithContext(ctx context.Context, saveSafariWebConfOptions *SaveSafariWebConfOptions) (result *SafariCertUploadResponse, response *core.DetailedResponse, err error) {
+	options := new(SaveSafariWebConfOptions)
+	if saveSafariWebConfOptions != nil {
+		*options = *saveSafariWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveSafariWebConfWithContext(ctx, options)
+}
+
+// DeleteSafariWebConf : Delete Safari Push Notifications settings
+// See PushServiceV1.DeleteSafariWebConf.
+func (client *AppClient) DeleteSafariWebConf(deleteSafariWebConfOptions *DeleteSafariWebConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteSafariWebConfWithContext(context.Background(), deleteSafariWebConfOptions)
+}
+
+// DeleteSafariWebConfWithContext is an alternate form of the DeleteSafariWebConf method which supports a Context parameter
+func (client *AppClient) DeleteSafariWebConfWithContext(ctx context.Context, deleteSafariWebConfOptions *DeleteSafariWebConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteSafariWebConfOptions)
+	if deleteSafariWebConfOptions != nil {
+		*options = *deleteSafariWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteSafariWebConfWithContext(ctx, options)
+}
+
+// GetGcmConfPublic : Get the GCM senderId
+// See PushServiceV1.GetGcmConfPublic.
+func (client *AppClient) GetGcmConfPublic(getGcmConfPublicOptions *GetGcmConfPublicOptions) (result *GCMCredendialsPublicModel, response *core.DetailedResponse, err error) {
+	return client.GetGcmConfPublicWithContext(context.Background(), getGcmConfPublicOptions)
+}
+
+// GetGcmConfPublicWithContext is an alternate form of the GetGcmConfPublic method which supports a Context parameter
+func (client *AppClient) GetGcmConfPublicWithContext(ctx context.Context, getGcmConfPublicOptions *GetGcmConfPublicOptions) (result *GCMCredendialsPublicModel, response *core.DetailedResponse, err error) {
+	options := new(GetGcmConfPublicOptions)
+	if getGcmConfPublicOptions != nil {
+		*options = *getGcmConfPublicOptions
+	}
+	if err = client.bind(&options.ApplicationID, nil, &options.ClientSecret, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetGcmConfPublicWithContext(ctx, options)
+}
+
+// GetChromeWebConf : Get the Chrome WebPush settings
+// See PushServiceV1.GetChromeWebConf.
+func (client *AppClient) GetChromeWebConf(getChromeWebConfOptions *GetChromeWebConfOptions) (result *ChromeWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.GetChromeWebConfWithContext(context.Background(), getChromeWebConfOptions)
+}
+
+// GetChromeWebConfWithContext is an alternate form of the GetChromeWebConf method which supports a Context parameter
+func (client *AppClient) GetChromeWebConfWithContext(ctx context.Context, getChromeWebConfOptions *GetChromeWebConfOptions) (result *ChromeWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(GetChromeWebConfOptions)
+	if getChromeWebConfOptions != nil {
+		*options = *getChromeWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetChromeWebConfWithContext(ctx, options)
+}
+
+// SaveChromeWebConf : Updates Chrome WebPush settings
+// See PushServiceV1.SaveChromeWebConf.
+func (client *AppClient) SaveChromeWebConf(saveChromeWebConfOptions *SaveChromeWebConfOptions) (result *ChromeWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.SaveChromeWebConfWithContext(context.Background(), saveChromeWebConfOptions)
+}
+
+// SaveChromeWebConfWithContext is an alternate form of the SaveChromeWebConf method which supports a Context parameter
+func (client *AppClient) SaveChromeWebConfWithContext(ctx context.Context, saveChromeWebConfOptions *SaveChromeWebConfOptions) (result *ChromeWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(SaveChromeWebConfOptions)
+	if saveChromeWebConfOptions != nil {
+		*options = *saveChromeWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveChromeWebConfWithContext(ctx, options)
+}
+
+// DeleteChromeWebConf : Delete Chrome WebPush Settings
+// See PushServiceV1.DeleteChromeWebConf.
+func (client *AppClient) DeleteChromeWebConf(deleteChromeWebConfOptions *DeleteChromeWebConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteChromeWebConfWithContext(context.Background(), deleteChromeWebConfOptions)
+}
+
+// DeleteChromeWebConfWithContext is an alternate form of the DeleteChromeWebConf method which supports a Context parameter
+func (client *AppClient) DeleteChromeWebConfWithContext(ctx context.Context, deleteChromeWebConfOptions *DeleteChromeWebConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteChromeWebConfOptions)
+	if deleteChromeWebConfOptions != nil {
+		*options = *deleteChromeWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteChromeWebConfWithContext(ctx, options)
+}
+
+// GetFirefoxWebConf : Get the Firefox WebPush settings
+// See PushServiceV1.GetFirefoxWebConf.
+func (client *AppClient) GetFirefoxWebConf(getFirefoxWebConfOptions *GetFirefoxWebConfOptions) (result *FirefoxWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.GetFirefoxWebConfWithContext(context.Background(), getFirefoxWebConfOptions)
+}
+
+// GetFirefoxWebConfWithContext is an alternate form of the GetFirefoxWebConf method which supports a Context parameter
+func (client *AppClient) GetFirefoxWebConfWithContext(ctx context.Context, getFirefoxWebConfOptions *GetFirefoxWebConfOptions) (result *FirefoxWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(GetFirefoxWebConfOptions)
+	if getFirefoxWebConfOptions != nil {
+		*options = *getFirefoxWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetFirefoxWebConfWithContext(ctx, options)
+}
+
+// SaveFirefoxWebConf : Updates Firefox WebPush settings
+// See PushServiceV1.SaveFirefoxWebConf.
+func (client *AppClient) SaveFirefoxWebConf(saveFirefoxWebConfOptions *SaveFirefoxWebConfOptions) (result *FirefoxWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.SaveFirefoxWebConfWithContext(context.Background(), saveFirefoxWebConfOptions)
+}
+
+// SaveFirefoxWebConfWithContext is an alternate form of the SaveFirefoxWebConf method which supports a Context parameter
+func (client *AppClient) SaveFirefoxWebConfWithContext(ctx context.Context, saveFirefoxWebConfOptions *SaveFirefoxWebConfOptions) (result *FirefoxWebPushCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(SaveFirefoxWebConfOptions)
+	if saveFirefoxWebConfOptions != nil {
+		*options = *saveFirefoxWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveFirefoxWebConfWithContext(ctx, options)
+}
+
+// DeleteFirefoxWebConf : Delete Firefox WebPush Settings
+// See PushServiceV1.DeleteFirefoxWebConf.
+func (client *AppClient) DeleteFirefoxWebConf(deleteFirefoxWebConfOptions *DeleteFirefoxWebConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteFirefoxWebConfWithContext(context.Background(), deleteFirefoxWebConfOptions)
+}
+
+// DeleteFirefoxWebConfWithContext is an alternate form of the DeleteFirefoxWebConf method which supports a Context parameter
+func (client *AppClient) DeleteFirefoxWebConfWithContext(ctx context.Context, deleteFirefoxWebConfOptions *DeleteFirefoxWebConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteFirefoxWebConfOptions)
+	if deleteFirefoxWebConfOptions != nil {
+		*options = *deleteFirefoxWebConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteFirefoxWebConfWithContext(ctx, options)
+}
+
+// GetChromeAppExtConf : Get the Chorme Apps-Extentions Push credentials settings
+// See PushServiceV1.GetChromeAppExtConf.
+func (client *AppClient) GetChromeAppExtConf(getChromeAppExtConfOptions *GetChromeAppExtConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.GetChromeAppExtConfWithContext(context.Background(), getChromeAppExtConfOptions)
+}
+
+// GetChromeAppExtConfWithContext is an alternate form of the GetChromeAppExtConf method which supports a Context parameter
+func (client *AppClient) GetChromeAppExtConfWithContext(ctx context.Context, getChromeAppExtConfOptions *GetChromeAppExtConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(GetChromeAppExtConfOptions)
+	if getChromeAppExtConfOptions != nil {
+		*options = *getChromeAppExtConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetChromeAppExtConfWithContext(ctx, options)
+}
+
+// SaveChromeAppExtConf : Updates Chorme Apps-Extentions Push credentials settings
+// See PushServiceV1.SaveChromeAppExtConf.
+func (client *AppClient) SaveChromeAppExtConf(saveChromeAppExtConfOptions *SaveChromeAppExtConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	return client.SaveChromeAppExtConfWithContext(context.Background(), saveChromeAppExtConfOptions)
+}
+
+// SaveChromeAppExtConfWithContext is an alternate form of the SaveChromeAppExtConf method which supports a Context parameter
+func (client *AppClient) SaveChromeAppExtConfWithContext(ctx context.Context, saveChromeAppExtConfOptions *SaveChromeAppExtConfOptions) (result *GCMCredendialsModel, response *core.DetailedResponse, err error) {
+	options := new(SaveChromeAppExtConfOptions)
+	if saveChromeAppExtConfOptions != nil {
+		*options = *saveChromeAppExtConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SaveChromeAppExtConfWithContext(ctx, options)
+}
+
+// DeleteChromeAppExtConf : Delete Chorme Apps-Extentions Push credentials settings
+// See PushServiceV1.DeleteChromeAppExtConf.
+func (client *AppClient) DeleteChromeAppExtConf(deleteChromeAppExtConfOptions *DeleteChromeAppExtConfOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteChromeAppExtConfWithContext(context.Background(), deleteChromeAppExtConfOptions)
+}
+
+// DeleteChromeAppExtConfWithContext is an alternate form of the DeleteChromeAppExtConf method which supports a Context parameter
+func (client *AppClient) DeleteChromeAppExtConfWithContext(ctx context.Context, deleteChromeAppExtConfOptions *DeleteChromeAppExtConfOptions) (response *core.DetailedResponse, err error) {
+	options := new(DeleteChromeAppExtConfOptions)
+	if deleteChromeAppExtConfOptions != nil {
+		*options = *deleteChromeAppExtConfOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.DeleteChromeAppExtConfWithContext(ctx, options)
+}
+
+// GetChromeAppExtConfPublic : Get the GCM senderId for Chorme Apps-Extentions Push credentials
+// See PushServiceV1.GetChromeAppExtConfPublic.
+func (client *AppClient) GetChromeAppExtConfPublic(getChromeAppExtConfPublicOptions *GetChromeAppExtConfPublicOptions) (result *GCMCredendialsPublicModel, response *core.DetailedResponse, err error) {
+	return client.GetChromeAppExtConfPublicWithContext(context.Background(), getChromeAppExtConfPublicOptions)
+}
+
+// GetChromeAppExtConfPublicWithContext is an alternate form of the GetChromeAppExtConfPublic method which supports a Context parameter
+func (client *AppClient) GetChromeAppExtConfPublicWithContext(ctx context.Context, getChromeAppExtConfPublicOptions *GetChromeAppExtConfPublicOptions) (result *GCMCredendialsPublicModel, response *core.DetailedResponse, err error) {
+	options := new(GetChromeAppExtConfPublicOptions)
+	if getChromeAppExtConfPublicOptions != nil {
+		*options = *getChromeAppExtConfPublicOptions
+	}
+	if err = client.bind(&options.ApplicationID, nil, &options.ClientSecret, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.GetChromeAppExtConfPublicWithContext(ctx, options)
+}
+
+// SendMessage : Send message with different options
+// See PushServiceV1.SendMessage.
+func (client *AppClient) SendMessage(sendMessageOptions *SendMessageOptions) (result *MessageResponseModel, response *core.DetailedResponse, err error) {
+	return client.SendMessageWithContext(context.Background(), sendMessageOptions)
+}
+
+// SendMessageWithContext is an alternate form of the SendMessage method which supports a Context parameter
+func (client *AppClient) SendMessageWithContext(ctx context.Context, sendMessageOptions *SendMessageOptions) (result *MessageResponseModel, response *core.DetailedResponse, err error) {
+	options := new(SendMessageOptions)
+	if sendMessageOptions != nil {
+		*options = *sendMessageOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SendMessageWithContext(ctx, options)
+}
+
+// SendMessagesInBulk : Send Bulk Messages
+// See PushServiceV1.SendMessagesInBulk.
+func (client *AppClient) SendMessagesInBulk(sendMessagesInBulkOptions *SendMessagesInBulkOptions) (result *MessagesArrayModel, response *core.DetailedResponse, err error) {
+	return client.SendMessagesInBulkWithContext(context.Background(), sendMessagesInBulkOptions)
+}
+
+// SendMessagesInBulkWithContext is an alternate form of the SendMessagesInBulk method which supports a Context parameter
+func (client *AppClient) SendMessagesInBulkWithContext(ctx context.Context, sendMessagesInBulkOptions *SendMessagesInBulkOptions) (result *MessagesArrayModel, response *core.DetailedResponse, err error) {
+	options := new(SendMessagesInBulkOptions)
+	if sendMessagesInBulkOptions != nil {
+		*options = *sendMessagesInBulkOptions
+	}
+	if err = client.bind(&options.ApplicationID, &options.AppSecret, nil, &options.AcceptLanguage); err != nil {
+		return
+	}
+	return client.PushService.SendMessagesInBulkWithContext(ctx, options)
+}
@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppClient`, func() {
+	var testServer *httptest.Server
+	var requests []*http.Request
+	var appClient *pushservicev1.AppClient
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "DELETE":
+				res.WriteHeader(204)
+			case "POST":
+				res.WriteHeader(202)
+				fmt.Fprintf(res, "%s", `{"message": {"alert": "Hello"}}`)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"apiKey": "testString", "senderId": "testString", "webpushServerKey": "testString"}`)
+			}
+		}))
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		var err error
+		appClient, err = pushServiceService.NewAppClient("testString")
+		Expect(err).To(BeNil())
+		appClient.SetAppSecret("testAppSecret").SetClientSecret("testClientSecret").SetAcceptLanguage("fr")
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke NewAppClient without an application ID`, func() {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		_, err := pushServiceService.NewAppClient("")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke operations without options`, func() {
+		_, _, err := appClient.GetSettings(nil)
+		Expect(err).To(BeNil())
+		response, err := appClient.DeleteGCMConf(nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].URL.Path).To(Equal("/apps/testString/settings"))
+		Expect(requests[0].Header.Get("appSecret")).To(Equal("testAppSecret"))
+		Expect(requests[0].Header.Get("clientSecret")).To(Equal(""))
+		Expect(requests[0].Header.Get("Accept-Language")).To(Equal("fr"))
+		Expect(requests[1].Method).To(Equal("DELETE"))
+		Expect(requests[1].URL.Path).To(Equal("/apps/testString/settings/gcmConf"))
+	})
+	It(`Invoke public operations with the client secret`, func() {
+		result, _, err := appClient.GetWebpushServerKey(nil)
+		Expect(err).To(BeNil())
+		Expect(*result.WebpushServerKey).To(Equal("testString"))
+		_, _, err = appClient.GetGcmConfPublic(nil)
+		Expect(err).To(BeNil())
+
+		for _, request := range requests {
+			Expect(request.Header.Get("clientSecret")).To(Equal("testClientSecret"))
+			Expect(request.Header.Get("appSecret")).To(Equal(""))
+		}
+	})
+	It(`Invoke operations with options`, func() {
+		_, _, err := appClient.SaveGCMConf(appClient.NewSaveGCMConfOptions("testString", "testString"))
+		Expect(err).To(BeNil())
+		Expect(requests[0].Method).To(Equal("PUT"))
+		Expect(requests[0].URL.Path).To(Equal("/apps/testString/settings/gcmConf"))
+
+		// The options of the caller override the client, and are left as they are.
+		sendMessageOptions := &pushservicev1.SendMessageOptions{Message: &pushservicev1.Message{Alert: core.StringPtr("Hello")}}
+		sendMessageOptions.SetAppSecret("otherAppSecret")
+		_, _, err = appClient.SendMessage(sendMessageOptions)
+		Expect(err).To(BeNil())
+		Expect(requests[1].URL.Path).To(Equal("/apps/testString/messages"))
+		Expect(requests[1].Header.Get("appSecret")).To(Equal("otherAppSecret"))
+		Expect(sendMessageOptions.ApplicationID).To(BeNil())
+		Expect(sendMessageOptions.AcceptLanguage).To(BeNil())
+	})
+	It(`Invoke operations with error: Options for another application`, func() {
+		getSettingsOptions := new(pushservicev1.GetSettingsOptions).SetApplicationID("otherApplication")
+		_, _, err := appClient.GetSettings(getSettingsOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("otherApplication"))
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Invoke operations with error: Param validation error`, func() {
+		_, _, err := appClient.SaveGCMConf(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AuthTypeAppSecret is the authentication type of AppSecretAuthenticator, for the AUTH_TYPE external configuration
+// property.
+const AuthTypeAppSecret = "appSecret"
+
+// PropNameAppSecret is the external configuration property holding the app secret, e.g. PUSH_SERVICE_APP_SECRET.
+const PropNameAppSecret = "APP_SECRET"
+
+// appSecretHeader is the header the push service reads the app secret from.
+const appSecretHeader = "appSecret"
+
+// AppSecretAuthenticator authenticates every request with the app secret of the application, sent as:
+//
+// 		appSecret: <app-secret>
+//
+// An AppSecret set in the options of an operation, or an appSecret header in their Headers, overrides it.
+type AppSecretAuthenticator struct {
+
+	// The app secret of the application [required].
+	AppSecret string
+
+	// An authenticator which also authenticates every request, such as an IAM authenticator [optional].
+	Authenticator core.Authenticator
+}
+
+// NewAppSecretAuthenticator constructs a new AppSecretAuthenticator instance.
+func NewAppSecretAuthenticator(appSecret string) (*AppSecretAuthenticator, error) {
+	obj := &AppSecretAuthenticator{
+		AppSecret: appSecret,
+	}
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator.
+func (AppSecretAuthenticator) AuthenticationType() string {
+	return AuthTypeAppSecret
+}
+
+// Authenticate adds the appSecret header to the request, unless the request already has one, and then applies the
+// wrapped authenticator, if any.
+func (authenticator *AppSecretAuthenticator) Authenticate(request *http.Request) error {
+	if !hasHeader(request.Header, appSecretHeader) {
+		// Set as the operations do, without canonicalizing the name.
+		request.Header[appSecretHeader] = []string{authenticator.AppSecret}
+	}
+	if authenticator.Authenticator != nil {
+		return authenticator.Authenticator.Authenticate(request)
+	}
+	return nil
+}
+
+// Validate the authenticator's configuration.
+//
+// Ensures the app secret is set, and validates the wrapped authenticator, if any.
+func (authenticator AppSecretAuthenticator) Validate() error {
+	if authenticator.AppSecret == "" {
+		return fmt.Errorf(core.ERRORMSG_PROP_MISSING, "AppSecret")
+	}
+	if authenticator.Authenticator != nil {
+		return authenticator.Authenticator.Validate()
+	}
+	return nil
+}
+
+// getAuthenticatorFromEnvironment returns an AppSecretAuthenticator or a ClientSecretAuthenticator if the external
+// configuration of the service sets AUTH_TYPE to appSecret or clientSecret, and otherwise the authenticator the core
+// library configures.
+func getAuthenticatorFromEnvironment(serviceName string) (core.Authenticator, error) {
+	properties, err := core.GetServiceProperties(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	authType := properties[core.PROPNAME_AUTH_TYPE]
+	switch {
+	case strings.EqualFold(authType, AuthTypeAppSecret):
+		return NewAppSecretAuthenticator(properties[PropNameAppSecret])
+	case strings.EqualFold(authType, AuthTypeClientSecret):
+		return NewClientSecretAuthenticator(properties[PropNameClientSecret])
+	}
+	return core.GetAuthenticatorFromEnvironment(serviceName)
+}
+
+// hasHeader reports whether header holds name, which core.RequestBuilder may have stored without canonicalizing it.
+func hasHeader(header http.Header, name string) bool {
+	return headerValues(header, name) != nil
+}
+
+// headerValues returns the values of name in header, whatever the case of its key.
+func headerValues(header http.Header, name string) (values []string) {
+	for key := range header {
+		if strings.EqualFold(key, name) {
+			values = append(values, header[key]...)
+		}
+	}
+	return values
+}
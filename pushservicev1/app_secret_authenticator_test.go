@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppSecretAuthenticator`, func() {
+	Describe(`NewAppSecretAuthenticator(appSecret string)`, func() {
+		It(`Construct an AppSecretAuthenticator`, func() {
+			authenticator, err := pushservicev1.NewAppSecretAuthenticator("my-app-secret")
+			Expect(err).To(BeNil())
+			Expect(authenticator.AuthenticationType()).To(Equal(pushservicev1.AuthTypeAppSecret))
+			Expect(authenticator.AppSecret).To(Equal("my-app-secret"))
+
+			authenticator, err = pushservicev1.NewAppSecretAuthenticator("")
+			Expect(err).ToNot(BeNil())
+			Expect(authenticator).To(BeNil())
+		})
+		It(`Validate the wrapped authenticator`, func() {
+			authenticator := &pushservicev1.AppSecretAuthenticator{
+				AppSecret:     "my-app-secret",
+				Authenticator: &core.BearerTokenAuthenticator{},
+			}
+			Expect(authenticator.Validate()).ToNot(BeNil())
+			authenticator.Authenticator = &core.BearerTokenAuthenticator{BearerToken: "token"}
+			Expect(authenticator.Validate()).To(BeNil())
+
+			request, _ := http.NewRequest("GET", "https://example.com", nil)
+			Expect(authenticator.Authenticate(request)).To(BeNil())
+			Expect(request.Header["appSecret"]).To(Equal([]string{"my-app-secret"}))
+			Expect(request.Header.Get("Authorization")).To(Equal("Bearer token"))
+		})
+	})
+
+	Describe(`Service requests`, func() {
+		var testServer *httptest.Server
+		var appSecrets []string
+		BeforeEach(func() {
+			appSecrets = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				appSecrets = append(appSecrets, req.Header.Get("appSecret"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Send the app secret with every operation, unless the options override it`, func() {
+			authenticator, err := pushservicev1.NewAppSecretAuthenticator("my-app-secret")
+			Expect(err).To(BeNil())
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: authenticator,
+			})
+			Expect(serviceErr).To(BeNil())
+
+			sendMessageOptions := pushServiceService.NewSendMessageOptions("testString", &pushservicev1.Message{Alert: core.StringPtr("Hello")})
+			_, _, err = pushServiceService.SendMessage(sendMessageOptions)
+			Expect(err).To(BeNil())
+			_, _, err = pushServiceService.SendMessage(sendMessageOptions.SetAppSecret("other-app-secret"))
+			Expect(err).To(BeNil())
+			_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+			Expect(err).To(BeNil())
+			_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString").
+				SetHeaders(map[string]string{"appSecret": "header-app-secret"}))
+			Expect(err).To(BeNil())
+
+			Expect(appSecrets).To(Equal([]string{"my-app-secret", "other-app-secret", "my-app-secret", "header-app-secret"}))
+		})
+	})
+
+	Describe(`NewPushServiceV1UsingExternalConfig(options *PushServiceV1Options)`, func() {
+		var testEnvironment = map[string]string{
+			"PUSH_SERVICE_URL":        "https://pushservicev1/api",
+			"PUSH_SERVICE_AUTH_TYPE":  "appsecret",
+			"PUSH_SERVICE_APP_SECRET": "my-app-secret",
+		}
+		It(`Create service client with an app secret from external config`, func() {
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1UsingExternalConfig(&pushservicev1.PushServiceV1Options{})
+			Expect(serviceErr).To(BeNil())
+			authenticator, ok := pushServiceService.Service.Options.Authenticator.(*pushservicev1.AppSecretAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(authenticator.AppSecret).To(Equal("my-app-secret"))
+		})
+	})
+})
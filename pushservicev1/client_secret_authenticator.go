@@ -0,0 +1,110 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AuthTypeClientSecret is the authentication type of ClientSecretAuthenticator, for the AUTH_TYPE external
+// configuration property.
+const AuthTypeClientSecret = "clientSecret"
+
+// PropNameClientSecret is the external configuration property holding the client secret, e.g.
+// PUSH_SERVICE_CLIENT_SECRET.
+const PropNameClientSecret = "CLIENT_SECRET"
+
+// clientSecretHeader is the header the push service reads the client secret from.
+const clientSecretHeader = "clientSecret"
+
+// publicPathSuffixes are the paths, below /apps/{applicationId}/settings, of the operations which accept a client
+// secret: GetGcmConfPublic, GetWebpushServerKey and GetChromeAppExtConfPublic.
+var publicPathSuffixes = []string{
+	"/settings/gcmConfPublic",
+	"/settings/webpushServerKey",
+	"/settings/chromeAppExtConfPublic",
+}
+
+// ClientSecretAuthenticator authenticates requests to the public operations, GetGcmConfPublic, GetWebpushServerKey and
+// GetChromeAppExtConfPublic, with the client secret of the application, sent as:
+//
+// 		clientSecret: <client-secret>
+//
+// It lets a front-end facing service use a PushServiceV1 without IAM credentials. Every other operation fails locally,
+// without a request being sent. A ClientSecret set in the options of an operation overrides it.
+type ClientSecretAuthenticator struct {
+
+	// The client secret of the application [required].
+	ClientSecret string
+}
+
+// NewClientSecretAuthenticator constructs a new ClientSecretAuthenticator instance.
+func NewClientSecretAuthenticator(clientSecret string) (*ClientSecretAuthenticator, error) {
+	obj := &ClientSecretAuthenticator{
+		ClientSecret: clientSecret,
+	}
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator.
+func (ClientSecretAuthenticator) AuthenticationType() string {
+	return AuthTypeClientSecret
+}
+
+// Authenticate adds the clientSecret header to a request to a public operation, unless the request already has one,
+// and returns an error for any other request.
+func (authenticator *ClientSecretAuthenticator) Authenticate(request *http.Request) error {
+	if !isPublicRequest(request) {
+		return fmt.Errorf("a client secret only authenticates the public operations, not %s %s",
+			request.Method, request.URL.Path)
+	}
+	if !hasHeader(request.Header, clientSecretHeader) {
+		// Set as the operations do, without canonicalizing the name.
+		request.Header[clientSecretHeader] = []string{authenticator.ClientSecret}
+	}
+	return nil
+}
+
+// Validate the authenticator's configuration.
+//
+// Ensures the client secret is set.
+func (authenticator ClientSecretAuthenticator) Validate() error {
+	if authenticator.ClientSecret == "" {
+		return fmt.Errorf(core.ERRORMSG_PROP_MISSING, "ClientSecret")
+	}
+	return nil
+}
+
+// isPublicRequest reports whether request is a GET of one of the public operations.
+func isPublicRequest(request *http.Request) bool {
+	if request.Method != http.MethodGet {
+		return false
+	}
+	for _, suffix := range publicPathSuffixes {
+		if strings.HasSuffix(request.URL.Path, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ClientSecretAuthenticator`, func() {
+	Describe(`NewClientSecretAuthenticator(clientSecret string)`, func() {
+		It(`Construct a ClientSecretAuthenticator`, func() {
+			authenticator, err := pushservicev1.NewClientSecretAuthenticator("my-client-secret")
+			Expect(err).To(BeNil())
+			Expect(authenticator.AuthenticationType()).To(Equal(pushservicev1.AuthTypeClientSecret))
+			Expect(authenticator.ClientSecret).To(Equal("my-client-secret"))
+
+			authenticator, err = pushservicev1.NewClientSecretAuthenticator("")
+			Expect(err).ToNot(BeNil())
+			Expect(authenticator).To(BeNil())
+		})
+	})
+
+	Describe(`Service requests`, func() {
+		var testServer *httptest.Server
+		var clientSecrets []string
+		BeforeEach(func() {
+			clientSecrets = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				clientSecrets = append(clientSecrets, req.Header.Get("clientSecret"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newService := func() *pushservicev1.PushServiceV1 {
+			authenticator, err := pushservicev1.NewClientSecretAuthenticator("my-client-secret")
+			Expect(err).To(BeNil())
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: authenticator,
+			})
+			Expect(serviceErr).To(BeNil())
+			return pushServiceService
+		}
+		It(`Send the client secret to the public operations`, func() {
+			pushServiceService := newService()
+
+			_, _, err := pushServiceService.GetGcmConfPublic(pushServiceService.NewGetGcmConfPublicOptions("testString"))
+			Expect(err).To(BeNil())
+			_, _, err = pushServiceService.GetWebpushServerKey(pushServiceService.NewGetWebpushServerKeyOptions("testString"))
+			Expect(err).To(BeNil())
+			_, _, err = pushServiceService.GetChromeAppExtConfPublic(pushServiceService.NewGetChromeAppExtConfPublicOptions("testString").
+				SetClientSecret("other-client-secret"))
+			Expect(err).To(BeNil())
+
+			Expect(clientSecrets).To(Equal([]string{"my-client-secret", "my-client-secret", "other-client-secret"}))
+		})
+		It(`Fail other operations without sending them`, func() {
+			pushServiceService := newService()
+
+			_, _, err := pushServiceService.GetGCMConf(pushServiceService.NewGetGCMConfOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("public operations"))
+			_, _, err = pushServiceService.SendMessage(pushServiceService.NewSendMessageOptions("testString",
+				&pushservicev1.Message{Alert: core.StringPtr("Hello")}))
+			Expect(err).ToNot(BeNil())
+			_, _, err = pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions("testString", "testString",
+				true, CreateMockReader("This is a mock file.")).SetForce(true))
+			Expect(err).ToNot(BeNil())
+
+			Expect(clientSecrets).To(BeEmpty())
+		})
+	})
+
+	Describe(`NewPushServiceV1UsingExternalConfig(options *PushServiceV1Options)`, func() {
+		var testEnvironment = map[string]string{
+			"PUSH_SERVICE_URL":           "https://pushservicev1/api",
+			"PUSH_SERVICE_AUTH_TYPE":     "clientSecret",
+			"PUSH_SERVICE_CLIENT_SECRET": "my-client-secret",
+		}
+		It(`Create service client with a client secret from external config`, func() {
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1UsingExternalConfig(&pushservicev1.PushServiceV1Options{})
+			Expect(serviceErr).To(BeNil())
+			authenticator, ok := pushServiceService.Service.Options.Authenticator.(*pushservicev1.ClientSecretAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(authenticator.ClientSecret).To(Equal("my-client-secret"))
+		})
+	})
+})
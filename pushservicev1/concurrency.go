@@ -0,0 +1,64 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// baseService returns the current BaseService of the service, which an operation builds and sends its request with.
+func (pushService *PushServiceV1) baseService() *core.BaseService {
+	if pushService.lock == nil {
+		return pushService.Service
+	}
+	pushService.lock.RLock()
+	defer pushService.lock.RUnlock()
+	return pushService.Service
+}
+
+// snapshot returns a shallow copy of the service, taken while it is not being reconfigured.
+func (pushService *PushServiceV1) snapshot() *PushServiceV1 {
+	if pushService.lock != nil {
+		pushService.lock.RLock()
+		defer pushService.lock.RUnlock()
+	}
+	copied := *pushService
+	return &copied
+}
+
+// reconfigure applies change to a copy of the BaseService and, if it succeeds, replaces the BaseService with the copy.
+//
+// The configuration methods of PushServiceV1 (SetServiceURL, SetDefaultHeaders, SetEnableGzipCompression,
+// EnableRetries and DisableRetries) go through reconfigure, so they are safe to call while other goroutines invoke
+// operations: the BaseService is never modified, and each operation takes the current BaseService once and uses it to
+// both build and send its request, so it sees the configuration either before or after a change. Changes made by
+// concurrent configuration methods are serialized.
+//
+// Modifying the BaseService directly through the Service field is not synchronized; it is only safe before the
+// service is shared.
+func (pushService *PushServiceV1) reconfigure(change func(service *core.BaseService) error) error {
+	if pushService.lock != nil {
+		pushService.lock.Lock()
+		defer pushService.lock.Unlock()
+	}
+	service := pushService.Service.Clone()
+	if err := change(service); err != nil {
+		return err
+	}
+	pushService.Service = service
+	return nil
+}
@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// These tests are meant to be run with the race detector: go test -race ./pushservicev1
+var _ = Describe(`Concurrent use`, func() {
+	var testServers [2]*httptest.Server
+	var requestCount int64
+	var headerMismatches int64
+	BeforeEach(func() {
+		requestCount = 0
+		headerMismatches = 0
+		for i := range testServers {
+			testServers[i] = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				atomic.AddInt64(&requestCount, 1)
+				// The default headers are always set together, so a request must not mix two configurations.
+				if req.Header.Get("X-First") != req.Header.Get("X-Second") {
+					atomic.AddInt64(&headerMismatches, 1)
+				}
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "POST" {
+					res.WriteHeader(202)
+					fmt.Fprintf(res, "%s", `{"message": {"alert": "Hello"}}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"apnsConf": "apnsConf"}`)
+			}))
+		}
+	})
+	AfterEach(func() {
+		for _, testServer := range testServers {
+			testServer.Close()
+		}
+	})
+	It(`Invoke operations while the service is reconfigured`, func() {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:            testServers[0].URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			ConfigCacheTTL: time.Millisecond,
+		})
+		Expect(serviceErr).To(BeNil())
+
+		const senders = 8
+		const operations = 25
+		var errors int64
+		var wait sync.WaitGroup
+		for i := 0; i < senders; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				message := &pushservicev1.Message{Alert: core.StringPtr("Hello")}
+				for j := 0; j < operations; j++ {
+					if _, _, err := pushServiceService.SendMessage(pushServiceService.NewSendMessageOptions("testString", message)); err != nil {
+						atomic.AddInt64(&errors, 1)
+					}
+					if _, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString")); err != nil {
+						atomic.AddInt64(&errors, 1)
+					}
+				}
+			}()
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer GinkgoRecover()
+			for i := 0; i < 50; i++ {
+				Expect(pushServiceService.SetServiceURL(testServers[i%2].URL)).To(BeNil())
+				value := fmt.Sprint(i)
+				pushServiceService.SetDefaultHeaders(http.Header{"X-First": {value}, "X-Second": {value}})
+				pushServiceService.SetEnableGzipCompression(i%2 == 0)
+				if i%2 == 0 {
+					pushServiceService.EnableRetries(1, time.Millisecond)
+				} else {
+					pushServiceService.DisableRetries()
+				}
+				clone := pushServiceService.Clone()
+				Expect(clone.SetServiceURL(testServers[0].URL)).To(BeNil())
+				_ = pushServiceService.GetServiceURL()
+				_ = pushServiceService.GetEnableGzipCompression()
+			}
+		}()
+
+		wait.Wait()
+		<-done
+		Expect(errors).To(BeZero())
+		Expect(headerMismatches).To(BeZero())
+		Expect(atomic.LoadInt64(&requestCount)).To(BeNumerically("<=", int64(2*senders*operations)))
+		Expect(atomic.LoadInt64(&requestCount)).To(BeNumerically(">=", int64(senders*operations)))
+	})
+	It(`Keep the transport options when retries are enabled`, func() {
+		var roundTrips int64
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServers[0].URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt64(&roundTrips, 1)
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		})
+		Expect(serviceErr).To(BeNil())
+
+		pushServiceService.EnableRetries(2, time.Millisecond)
+		_, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		pushServiceService.DisableRetries()
+		_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(roundTrips).To(Equal(int64(2)))
+	})
+	It(`Send a request with the configuration it was built with`, func() {
+		received := make(chan string, 1)
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			received <- req.Header.Get("X-First")
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"apnsConf": "apnsConf"}`)
+		}))
+		defer testServer.Close()
+		var pushServiceService *pushservicev1.PushServiceV1
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Interceptors: []pushservicev1.Interceptor{
+				func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+					// Reconfigure the service after the request is built, but before it is sent.
+					pushServiceService.SetDefaultHeaders(http.Header{"X-First": {"after"}})
+					return next(operation, request, result)
+				},
+			},
+		})
+		Expect(serviceErr).To(BeNil())
+		pushServiceService.SetDefaultHeaders(http.Header{"X-First": {"before"}})
+
+		_, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(<-received).To(Equal("before"))
+		_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(<-received).To(Equal("after"))
+	})
+	It(`Do not modify the BaseService of a running operation`, func() {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServers[0].URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		before := pushServiceService.Service
+		Expect(pushServiceService.SetServiceURL(testServers[1].URL)).To(BeNil())
+		Expect(before.GetServiceURL()).To(Equal(testServers[0].URL))
+		Expect(pushServiceService.Service.GetServiceURL()).To(Equal(testServers[1].URL))
+
+		// A failed change leaves the service as it was.
+		Expect(pushServiceService.SetServiceURL("{BAD_URL_STRING")).ToNot(BeNil())
+		Expect(pushServiceService.GetServiceURL()).To(Equal(testServers[1].URL))
+	})
+})
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// configPath matches the paths of the settings operations, such as /apps/{applicationId}/settings/gcmConf, capturing
+// the application ID.
+var configPath = regexp.MustCompile(`/apps/([^/]+)/settings(?:/[^/]+)?$`)
+
+// ConfigCacheStats : The statistics of the configuration cache of a PushServiceV1, enabled with
+// PushServiceV1Options.ConfigCacheTTL.
+type ConfigCacheStats struct {
+	// The number of reads answered from the cache.
+	Hits uint64
+
+	// The number of reads sent to the service, because their result was not cached or had expired.
+	Misses uint64
+
+	// The number of cached results discarded by a Save or Delete operation, or by InvalidateConfigCache.
+	Invalidations uint64
+
+	// The number of results currently cached, including expired ones not discarded yet.
+	Entries int
+}
+
+// ConfigCacheStats returns the statistics of the configuration cache. They are all zero when the cache is disabled.
+func (pushService *PushServiceV1) ConfigCacheStats() ConfigCacheStats {
+	return pushService.configCache.statistics()
+}
+
+// InvalidateConfigCache discards the cached configuration of an application, or of every application when
+// applicationID is empty. Use it after the configuration was changed by another client.
+func (pushService *PushServiceV1) InvalidateConfigCache(applicationID string) {
+	if applicationID != "" {
+		// The cache is keyed by the application ID as escaped in the request path.
+		applicationID = url.PathEscape(applicationID)
+	}
+	pushService.configCache.invalidate(applicationID)
+}
+
+// configCache holds the successful results of the settings read operations (GetSettings, GetApnsConf, ...) for a
+// TTL. A Save or Delete operation of an application discards all of its cached results. A nil cache is valid and
+// caches nothing.
+type configCache struct {
+	ttl time.Duration
+
+	mutex   sync.Mutex
+	entries map[string]configCacheEntry
+	stats   ConfigCacheStats
+
+	// Incremented by every invalidation, so that a read which started before a write does not cache its result.
+	generation uint64
+}
+
+type configCacheEntry struct {
+	applicationID string
+	statusCode    int
+	headers       http.Header
+	result        map[string]json.RawMessage
+	expires       time.Time
+}
+
+// newConfigCache returns a cache with the given TTL, or nil if the TTL is not positive.
+func newConfigCache(ttl time.Duration) *configCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &configCache{
+		ttl:     ttl,
+		entries: make(map[string]configCacheEntry),
+	}
+}
+
+// configApplicationID returns the application ID of a settings operation, or "" for the other operations.
+func configApplicationID(request *http.Request) string {
+	match := configPath.FindStringSubmatch(request.URL.EscapedPath())
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// configCacheKey identifies a read: its URL, and the headers which may change its result.
+func configCacheKey(request *http.Request) string {
+	hash := sha256.New()
+	for _, name := range []string{"appSecret", "clientSecret", "Accept-Language"} {
+		hash.Write([]byte(strings.Join(headerValues(request.Header, name), ",") + "\n"))
+	}
+	return request.URL.String() + " " + hex.EncodeToString(hash.Sum(nil))
+}
+
+// get answers a read from the cache. result must be the *map[string]json.RawMessage the operations unmarshal into. On a
+// miss, it returns the generation to pass to update.
+func (cache *configCache) get(request *http.Request, result interface{}) (response *core.DetailedResponse, generation uint64, hit bool) {
+	rawResult, ok := result.(*map[string]json.RawMessage)
+	if cache == nil || !ok || request.Method != http.MethodGet || configApplicationID(request) == "" {
+		return nil, 0, false
+	}
+	key := configCacheKey(request)
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(cache.entries, key)
+		cache.stats.Misses++
+		return nil, cache.generation, false
+	}
+	cache.stats.Hits++
+	*rawResult = copyRawResult(entry.result)
+	return &core.DetailedResponse{StatusCode: entry.statusCode, Headers: entry.headers.Clone()}, 0, true
+}
+
+// update caches the result of a successful read, unless the cache was invalidated since get returned generation, or
+// discards the cached results of the application of a write.
+func (cache *configCache) update(request *http.Request, response *core.DetailedResponse, err error, result interface{}, generation uint64) {
+	applicationID := configApplicationID(request)
+	if cache == nil || applicationID == "" {
+		return
+	}
+	if request.Method != http.MethodGet {
+		// Even a failed write may have changed the configuration.
+		cache.invalidate(applicationID)
+		return
+	}
+	rawResult, ok := result.(*map[string]json.RawMessage)
+	if !ok || err != nil || response == nil || response.StatusCode < 200 || response.StatusCode >= 300 {
+		return
+	}
+
+	key := configCacheKey(request)
+	now := time.Now()
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.generation != generation {
+		return
+	}
+	for other, entry := range cache.entries {
+		if now.After(entry.expires) {
+			delete(cache.entries, other)
+		}
+	}
+	cache.entries[key] = configCacheEntry{
+		applicationID: applicationID,
+		statusCode:    response.StatusCode,
+		headers:       response.Headers.Clone(),
+		result:        copyRawResult(*rawResult),
+		expires:       now.Add(cache.ttl),
+	}
+}
+
+// copyRawResult copies a raw result, which the unmarshal functions of the models consume.
+func copyRawResult(rawResult map[string]json.RawMessage) map[string]json.RawMessage {
+	copied := make(map[string]json.RawMessage, len(rawResult))
+	for name, value := range rawResult {
+		copied[name] = value
+	}
+	return copied
+}
+
+func (cache *configCache) invalidate(applicationID string) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.generation++
+	for key, entry := range cache.entries {
+		if applicationID == "" || entry.applicationID == applicationID {
+			delete(cache.entries, key)
+			cache.stats.Invalidations++
+		}
+	}
+}
+
+func (cache *configCache) statistics() ConfigCacheStats {
+	if cache == nil {
+		return ConfigCacheStats{}
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	stats := cache.stats
+	stats.Entries = len(cache.entries)
+	return stats
+}
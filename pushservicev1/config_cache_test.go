@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Configuration cache`, func() {
+	var testServer *httptest.Server
+	var requests []string
+	var gcmConf map[string]interface{}
+	BeforeEach(func() {
+		requests = nil
+		gcmConf = map[string]interface{}{"apiKey": "testString", "senderId": "testString"}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "GET /apps/testString/settings", "GET /apps/otherApplication/settings":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"gcmConf": "gcmConf"}`)
+			case "GET /apps/testString/settings/gcmConf":
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(gcmConf)).To(BeNil())
+			case "PUT /apps/testString/settings/gcmConf":
+				Expect(json.NewDecoder(req.Body).Decode(&gcmConf)).To(BeNil())
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(gcmConf)).To(BeNil())
+			default:
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newService := func(ttl time.Duration) *pushservicev1.PushServiceV1 {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			ConfigCacheTTL: ttl,
+		})
+		Expect(serviceErr).To(BeNil())
+		return pushServiceService
+	}
+	getGCMConf := func(pushServiceService *pushservicev1.PushServiceV1) *pushservicev1.GCMCredendialsModel {
+		result, response, err := pushServiceService.GetGCMConf(pushServiceService.NewGetGCMConfOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(response.Result).To(Equal(result))
+		return result
+	}
+	It(`Send every read without a TTL`, func() {
+		pushServiceService := newService(0)
+		getGCMConf(pushServiceService)
+		getGCMConf(pushServiceService)
+		Expect(requests).To(HaveLen(2))
+		Expect(pushServiceService.ConfigCacheStats()).To(Equal(pushservicev1.ConfigCacheStats{}))
+	})
+	It(`Answer repeated reads from the cache`, func() {
+		pushServiceService := newService(time.Minute)
+		first := getGCMConf(pushServiceService)
+		second := getGCMConf(pushServiceService)
+		Expect(second).To(Equal(first))
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(requests).To(HaveLen(1))
+		Expect(pushServiceService.ConfigCacheStats()).To(Equal(pushservicev1.ConfigCacheStats{Hits: 1, Misses: 1, Entries: 1}))
+
+		// A different Accept-Language is another entry.
+		getGCMConfOptions := pushServiceService.NewGetGCMConfOptions("testString").SetAcceptLanguage("fr")
+		_, _, err := pushServiceService.GetGCMConf(getGCMConfOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+	})
+	It(`Invalidate the application on a write`, func() {
+		pushServiceService := newService(time.Minute)
+		_, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("otherApplication"))
+		Expect(err).To(BeNil())
+		getGCMConf(pushServiceService)
+
+		_, _, err = pushServiceService.SaveGCMConf(pushServiceService.NewSaveGCMConfOptions("testString", "newApiKey", "testString"))
+		Expect(err).To(BeNil())
+		Expect(*getGCMConf(pushServiceService).ApiKey).To(Equal("newApiKey"))
+		_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		_, _, err = pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("otherApplication"))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"GET /apps/testString/settings",
+			"GET /apps/otherApplication/settings",
+			"GET /apps/testString/settings/gcmConf",
+			"PUT /apps/testString/settings/gcmConf",
+			"GET /apps/testString/settings/gcmConf",
+			"GET /apps/testString/settings",
+		}))
+		Expect(pushServiceService.ConfigCacheStats().Invalidations).To(Equal(uint64(2)))
+
+		pushServiceService.InvalidateConfigCache("testString")
+		Expect(pushServiceService.ConfigCacheStats().Entries).To(Equal(1))
+		pushServiceService.InvalidateConfigCache("")
+		Expect(pushServiceService.ConfigCacheStats().Entries).To(BeZero())
+	})
+	It(`Do not cache errors`, func() {
+		pushServiceService := newService(time.Minute)
+		for i := 0; i < 2; i++ {
+			_, response, err := pushServiceService.GetApnsConf(pushServiceService.NewGetApnsConfOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		}
+		Expect(requests).To(HaveLen(2))
+		Expect(pushServiceService.ConfigCacheStats()).To(Equal(pushservicev1.ConfigCacheStats{Misses: 2}))
+	})
+	It(`Expire entries after the TTL`, func() {
+		pushServiceService := newService(50 * time.Millisecond)
+		getGCMConf(pushServiceService)
+		time.Sleep(100 * time.Millisecond)
+		getGCMConf(pushServiceService)
+		Expect(requests).To(HaveLen(2))
+		Expect(pushServiceService.ConfigCacheStats().Hits).To(BeZero())
+	})
+})
@@ -0,0 +1,385 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants for the platforms reported by CheckCredentialHealth.
+const (
+	CredentialPlatform_Apns         = "apns"
+	CredentialPlatform_ChromeAppExt = "chromeAppExt"
+	CredentialPlatform_ChromeWeb    = "chromeWeb"
+	CredentialPlatform_FirefoxWeb   = "firefoxWeb"
+	CredentialPlatform_Gcm          = "gcm"
+	CredentialPlatform_SafariWeb    = "safariWeb"
+)
+
+// credentialExpiryProperty is the property which holds the expiry date of configurations that do not declare it.
+const credentialExpiryProperty = "validUntil"
+
+// credentialExpiryLayouts are the date formats accepted for the expiry date of a credential.
+var credentialExpiryLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"Jan _2 15:04:05 2006 MST",
+	time.RFC1123,
+	time.UnixDate,
+}
+
+// ParseCredentialExpiry parses the expiry date of a credential, such as ApnsCertUploadResponse.ValidUntil.
+func ParseCredentialExpiry(value string) (time.Time, error) {
+	for _, layout := range credentialExpiryLayouts {
+		if expiresAt, err := time.Parse(layout, value); err == nil {
+			return expiresAt, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized expiry date '%s'", value)
+}
+
+// CredentialStatus : The health of the credentials of one platform.
+type CredentialStatus struct {
+	// The platform, one of the CredentialPlatform_* constants.
+	Platform string
+
+	// Whether the platform is configured for the application.
+	Configured bool
+
+	// The time at which the credentials expire, if the service reports it.
+	ExpiresAt *time.Time
+
+	// The number of whole days until the credentials expire, negative once they have expired.
+	DaysToExpiry *int64
+
+	// The error which prevented the configuration from being retrieved or its expiry date from being parsed.
+	Error error
+}
+
+// CredentialHealthReport : The health of the credentials of all platforms of an application.
+type CredentialHealthReport struct {
+	// Unique ID of the application.
+	ApplicationID string
+
+	// The time at which the report was produced.
+	CheckedAt time.Time
+
+	// The settings links returned by GetSettings.
+	Settings *AppSettingsObjResponse
+
+	// The status of each platform, ordered by platform name.
+	Platforms []CredentialStatus
+}
+
+// Configured returns the platforms that are configured for the application.
+func (report *CredentialHealthReport) Configured() (platforms []string) {
+	for _, status := range report.Platforms {
+		if status.Configured {
+			platforms = append(platforms, status.Platform)
+		}
+	}
+	return
+}
+
+// Missing returns the platforms that are not configured for the application.
+func (report *CredentialHealthReport) Missing() (platforms []string) {
+	for _, status := range report.Platforms {
+		if !status.Configured && status.Error == nil {
+			platforms = append(platforms, status.Platform)
+		}
+	}
+	return
+}
+
+// ExpiringWithin returns the status of the platforms whose credentials expire within the given number of days,
+// including those that have already expired.
+func (report *CredentialHealthReport) ExpiringWithin(days int64) (expiring []CredentialStatus) {
+	for _, status := range report.Platforms {
+		if status.DaysToExpiry != nil && *status.DaysToExpiry <= days {
+			expiring = append(expiring, status)
+		}
+	}
+	return
+}
+
+// credentialCheck retrieves the configuration of one platform and returns its expiry date, if any.
+type credentialCheck struct {
+	platform string
+	fetch    func(ctx context.Context, pushService *PushServiceV1, applicationID string) (validUntil *string, response *core.DetailedResponse, err error)
+}
+
+// credentialChecks lists the platforms checked by CheckCredentialHealth.
+var credentialChecks = []credentialCheck{
+	{CredentialPlatform_Apns, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetApnsConfWithContext(ctx, pushService.NewGetApnsConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return result.ValidUntil, response, nil
+	}},
+	{CredentialPlatform_ChromeAppExt, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetChromeAppExtConfWithContext(ctx, pushService.NewGetChromeAppExtConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return credentialExpiryOf(result), response, nil
+	}},
+	{CredentialPlatform_ChromeWeb, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetChromeWebConfWithContext(ctx, pushService.NewGetChromeWebConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return credentialExpiryOf(result), response, nil
+	}},
+	{CredentialPlatform_FirefoxWeb, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetFirefoxWebConfWithContext(ctx, pushService.NewGetFirefoxWebConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return credentialExpiryOf(result), response, nil
+	}},
+	{CredentialPlatform_Gcm, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetGCMConfWithContext(ctx, pushService.NewGetGCMConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return credentialExpiryOf(result), response, nil
+	}},
+	{CredentialPlatform_SafariWeb, func(ctx context.Context, pushService *PushServiceV1, applicationID string) (*string, *core.DetailedResponse, error) {
+		result, response, err := pushService.GetSafariWebConfWithContext(ctx, pushService.NewGetSafariWebConfOptions(applicationID))
+		if err != nil {
+			return nil, response, err
+		}
+		return credentialExpiryOf(result), response, nil
+	}},
+}
+
+// credentialExpiryOf returns the validUntil property of a configuration whose model does not declare an expiry date.
+func credentialExpiryOf(model interface{ GetProperty(key string) interface{} }) *string {
+	if validUntil, ok := model.GetProperty(credentialExpiryProperty).(string); ok {
+		return &validUntil
+	}
+	return nil
+}
+
+// CheckCredentialHealth : Report the credential health of all platforms
+// Retrieves the settings and the configuration of every platform of the application and reports which platforms are
+// configured and how many days remain until their credentials expire.
+func (pushService *PushServiceV1) CheckCredentialHealth(applicationID string) (*CredentialHealthReport, error) {
+	return pushService.CheckCredentialHealthWithContext(context.Background(), applicationID)
+}
+
+// CheckCredentialHealthWithContext is an alternate form of the CheckCredentialHealth method which supports a Context parameter
+func (pushService *PushServiceV1) CheckCredentialHealthWithContext(ctx context.Context, applicationID string) (*CredentialHealthReport, error) {
+	settings, _, err := pushService.GetSettingsWithContext(ctx, pushService.NewGetSettingsOptions(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CredentialHealthReport{
+		ApplicationID: applicationID,
+		CheckedAt:     time.Now(),
+		Settings:      settings,
+	}
+	for _, check := range credentialChecks {
+		status := CredentialStatus{Platform: check.platform}
+		validUntil, response, err := check.fetch(ctx, pushService, applicationID)
+		switch {
+		case err != nil && response != nil && response.StatusCode == http.StatusNotFound:
+		case err != nil:
+			status.Error = err
+		default:
+			status.Configured = true
+			if validUntil != nil && *validUntil != "" {
+				status.setExpiry(*validUntil, report.CheckedAt)
+			}
+		}
+		report.Platforms = append(report.Platforms, status)
+	}
+	sort.Slice(report.Platforms, func(i, j int) bool {
+		return report.Platforms[i].Platform < report.Platforms[j].Platform
+	})
+	return report, nil
+}
+
+func (status *CredentialStatus) setExpiry(validUntil string, now time.Time) {
+	expiresAt, err := ParseCredentialExpiry(validUntil)
+	if err != nil {
+		status.Error = fmt.Errorf("%s: %s", status.Platform, err.Error())
+		return
+	}
+	days := int64(math.Floor(expiresAt.Sub(now).Hours() / 24))
+	status.ExpiresAt = &expiresAt
+	status.DaysToExpiry = &days
+}
+
+// DefaultCredentialWarningThresholds are the numbers of days before expiry at which a CredentialHealthWatcher warns.
+var DefaultCredentialWarningThresholds = []int64{30, 7, 1}
+
+// CredentialWarning : A warning that the credentials of a platform expire within a threshold.
+type CredentialWarning struct {
+	// Unique ID of the application.
+	ApplicationID string
+
+	// The platform, one of the CredentialPlatform_* constants.
+	Platform string
+
+	// The time at which the credentials expire.
+	ExpiresAt time.Time
+
+	// The number of whole days until the credentials expire, negative once they have expired.
+	DaysToExpiry int64
+
+	// The smallest threshold, in days, that DaysToExpiry has crossed.
+	Threshold int64
+}
+
+// CredentialHealthWatcherOptions : The options of a CredentialHealthWatcher.
+type CredentialHealthWatcherOptions struct {
+	// Unique ID of the application to watch.
+	ApplicationID string
+
+	// How often the credential health is checked. Defaults to 24 hours.
+	Interval time.Duration
+
+	// The numbers of days before expiry at which to warn. Defaults to DefaultCredentialWarningThresholds.
+	Thresholds []int64
+
+	// Called for each threshold crossed. Defaults to logging the warning with the core logger.
+	OnWarning func(warning CredentialWarning)
+
+	// Called when a check fails. Defaults to logging the error with the core logger.
+	OnError func(err error)
+}
+
+// CredentialHealthWatcher periodically checks the credential health of an application in the background and warns once
+// each time the credentials of a platform cross one of the thresholds.
+type CredentialHealthWatcher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// WatchCredentialHealth starts a CredentialHealthWatcher, which performs its first check immediately and runs until
+// Stop is called or ctx is done.
+func (pushService *PushServiceV1) WatchCredentialHealth(ctx context.Context, options *CredentialHealthWatcherOptions) (*CredentialHealthWatcher, error) {
+	if options == nil || options.ApplicationID == "" {
+		return nil, fmt.Errorf("options.ApplicationID is required")
+	}
+
+	interval := options.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	thresholds := append([]int64(nil), options.Thresholds...)
+	if len(thresholds) == 0 {
+		thresholds = append(thresholds, DefaultCredentialWarningThresholds...)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+
+	onWarning := options.OnWarning
+	if onWarning == nil {
+		onWarning = func(warning CredentialWarning) {
+			core.GetLogger().Warn("The %s credentials of application '%s' expire in %d days (%s)",
+				warning.Platform, warning.ApplicationID, warning.DaysToExpiry, warning.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	onError := options.OnError
+	if onError == nil {
+		onError = func(err error) {
+			core.GetLogger().Warn("Unable to check the credential health of application '%s': %s", options.ApplicationID, err.Error())
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	watcher := &CredentialHealthWatcher{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(watcher.done)
+
+		// The smallest threshold already warned about, per platform and expiry date.
+		warned := make(map[string]int64)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			report, err := pushService.CheckCredentialHealthWithContext(ctx, options.ApplicationID)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				onError(err)
+			} else {
+				for _, warning := range credentialWarnings(report, thresholds, warned) {
+					onWarning(warning)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return watcher, nil
+}
+
+// credentialWarnings returns the warnings for the thresholds crossed since the previous report.
+func credentialWarnings(report *CredentialHealthReport, thresholds []int64, warned map[string]int64) (warnings []CredentialWarning) {
+	for _, status := range report.Platforms {
+		if status.DaysToExpiry == nil {
+			continue
+		}
+		for _, threshold := range thresholds {
+			if *status.DaysToExpiry > threshold {
+				continue
+			}
+			key := status.Platform + "/" + status.ExpiresAt.Format(time.RFC3339Nano)
+			if previous, ok := warned[key]; !ok || threshold < previous {
+				warned[key] = threshold
+				warnings = append(warnings, CredentialWarning{
+					ApplicationID: report.ApplicationID,
+					Platform:      status.Platform,
+					ExpiresAt:     *status.ExpiresAt,
+					DaysToExpiry:  *status.DaysToExpiry,
+					Threshold:     threshold,
+				})
+			}
+			break
+		}
+	}
+	return
+}
+
+// Stop stops the watcher and waits for a check in progress to finish.
+func (watcher *CredentialHealthWatcher) Stop() {
+	watcher.once.Do(watcher.cancel)
+	<-watcher.done
+}
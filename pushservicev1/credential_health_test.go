@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Credential health`, func() {
+	Describe(`ParseCredentialExpiry(value string)`, func() {
+		It(`Parses the supported date formats`, func() {
+			expected := time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+			for _, value := range []string{"2027-03-01T00:00:00Z", "2027-03-01T00:00:00.000Z", "2027-03-01", "2027-03-01 00:00:00", "Mar  1 00:00:00 2027 GMT"} {
+				expiresAt, err := pushservicev1.ParseCredentialExpiry(value)
+				Expect(err).To(BeNil(), value)
+				Expect(expiresAt.Equal(expected)).To(BeTrue(), value)
+			}
+			_, err := pushservicev1.ParseCredentialExpiry("next year")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`CheckCredentialHealth(applicationID string)`, func() {
+		var testServer *httptest.Server
+		var apnsValidUntil string
+		var gcmValidUntil string
+		var settingsRequests int32
+		BeforeEach(func() {
+			atomic.StoreInt32(&settingsRequests, 0)
+			apnsValidUntil = time.Now().Add(10*24*time.Hour + time.Hour).UTC().Format(time.RFC3339)
+			gcmValidUntil = ""
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("GET"))
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/apps/testString/settings":
+					atomic.AddInt32(&settingsRequests, 1)
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"apnsConf": "ApnsConf", "gcmConf": "GcmConf"}`)
+				case "/apps/testString/settings/apnsConf":
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"certificate": "Certificate", "isSandBox": true, "validUntil": "%s"}`, apnsValidUntil)
+				case "/apps/testString/settings/gcmConf":
+					res.WriteHeader(200)
+					if gcmValidUntil == "" {
+						fmt.Fprintf(res, "%s", `{"apiKey": "ApiKey", "senderId": "SenderID"}`)
+					} else {
+						fmt.Fprintf(res, `{"apiKey": "ApiKey", "senderId": "SenderID", "validUntil": "%s"}`, gcmValidUntil)
+					}
+				case "/apps/testString/settings/safariWebConf":
+					res.WriteHeader(400)
+					fmt.Fprintf(res, "%s", `{"message": "Bad request"}`)
+				default:
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newService := func() *pushservicev1.PushServiceV1 {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			return pushServiceService
+		}
+		It(`Invoke CheckCredentialHealth successfully`, func() {
+			pushServiceService := newService()
+
+			report, err := pushServiceService.CheckCredentialHealth("testString")
+			Expect(err).To(BeNil())
+			Expect(report.ApplicationID).To(Equal("testString"))
+			Expect(report.Settings.ApnsConf).To(Equal(core.StringPtr("ApnsConf")))
+			Expect(report.Platforms).To(HaveLen(6))
+			Expect(report.Configured()).To(Equal([]string{pushservicev1.CredentialPlatform_Apns, pushservicev1.CredentialPlatform_Gcm}))
+			Expect(report.Missing()).To(Equal([]string{pushservicev1.CredentialPlatform_ChromeAppExt,
+				pushservicev1.CredentialPlatform_ChromeWeb, pushservicev1.CredentialPlatform_FirefoxWeb}))
+
+			apns := report.Platforms[0]
+			Expect(apns.Platform).To(Equal(pushservicev1.CredentialPlatform_Apns))
+			Expect(apns.DaysToExpiry).To(Equal(core.Int64Ptr(10)))
+			Expect(report.ExpiringWithin(30)).To(HaveLen(1))
+			Expect(report.ExpiringWithin(7)).To(BeEmpty())
+
+			gcm := report.Platforms[4]
+			Expect(gcm.Platform).To(Equal(pushservicev1.CredentialPlatform_Gcm))
+			Expect(gcm.Configured).To(BeTrue())
+			Expect(gcm.ExpiresAt).To(BeNil())
+
+			safari := report.Platforms[5]
+			Expect(safari.Platform).To(Equal(pushservicev1.CredentialPlatform_SafariWeb))
+			Expect(safari.Configured).To(BeFalse())
+			Expect(safari.Error).ToNot(BeNil())
+		})
+		It(`Invoke CheckCredentialHealth with an expired and an unparseable expiry date`, func() {
+			pushServiceService := newService()
+			apnsValidUntil = time.Now().Add(-36 * time.Hour).UTC().Format(time.RFC3339)
+			gcmValidUntil = "soon"
+
+			report, err := pushServiceService.CheckCredentialHealth("testString")
+			Expect(err).To(BeNil())
+			Expect(report.Platforms[0].DaysToExpiry).To(Equal(core.Int64Ptr(-2)))
+			Expect(report.Platforms[4].Configured).To(BeTrue())
+			Expect(report.Platforms[4].Error).ToNot(BeNil())
+		})
+		It(`Invoke CheckCredentialHealth for an unknown application`, func() {
+			pushServiceService := newService()
+
+			report, err := pushServiceService.CheckCredentialHealth("unknownApp")
+			Expect(err).ToNot(BeNil())
+			Expect(report).To(BeNil())
+		})
+		It(`Invoke WatchCredentialHealth and warn once per threshold`, func() {
+			pushServiceService := newService()
+			apnsValidUntil = time.Now().Add(5*24*time.Hour + time.Hour).UTC().Format(time.RFC3339)
+
+			var mutex sync.Mutex
+			var warnings []pushservicev1.CredentialWarning
+			watcher, err := pushServiceService.WatchCredentialHealth(context.Background(), &pushservicev1.CredentialHealthWatcherOptions{
+				ApplicationID: "testString",
+				Interval:      10 * time.Millisecond,
+				Thresholds:    []int64{30, 7},
+				OnWarning: func(warning pushservicev1.CredentialWarning) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					warnings = append(warnings, warning)
+				},
+				OnError: func(err error) {
+					defer GinkgoRecover()
+					Fail(err.Error())
+				},
+			})
+			Expect(err).To(BeNil())
+			// Several checks run before the watcher is stopped, but the crossed threshold is reported only once.
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&settingsRequests)
+			}, 5*time.Second).Should(BeNumerically(">=", 5))
+			watcher.Stop()
+			watcher.Stop()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Platform).To(Equal(pushservicev1.CredentialPlatform_Apns))
+			Expect(warnings[0].DaysToExpiry).To(Equal(int64(5)))
+			Expect(warnings[0].Threshold).To(Equal(int64(7)))
+		})
+		It(`Invoke WatchCredentialHealth without an application ID`, func() {
+			pushServiceService := newService()
+
+			watcher, err := pushServiceService.WatchCredentialHealth(context.Background(), &pushservicev1.CredentialHealthWatcherOptions{})
+			Expect(err).ToNot(BeNil())
+			Expect(watcher).To(BeNil())
+		})
+	})
+})
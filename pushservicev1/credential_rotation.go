@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultCredentialRollbackTimeout is how long the rollback of a failed credential rotation may take, unless
+// PushServiceV1Options.CredentialRollbackTimeout sets otherwise.
+const DefaultCredentialRollbackTimeout = 30 * time.Second
+
+// CredentialVerifier checks that newly saved credentials work, for example by sending a validate-only message to a
+// canary device. It returns an error if they do not.
+type CredentialVerifier func(ctx context.Context) error
+
+// CredentialRotationResult : The outcome of a credential rotation.
+type CredentialRotationResult struct {
+	// The platform, one of the CredentialPlatform_* constants.
+	Platform string
+
+	// The configuration before the rotation, or nil if the platform was not configured.
+	Previous interface{}
+
+	// The configuration returned when the new credentials were saved.
+	Saved interface{}
+
+	// The error returned by the verifier, if the new credentials failed verification.
+	VerificationError error
+
+	// Whether the previous configuration was restored, or removed if there was none, after verification failed.
+	RolledBack bool
+
+	// The error which prevented the previous configuration from being restored, if the rollback failed.
+	RollbackError error
+}
+
+// credentialRotation holds the operations rotate performs for one platform.
+type credentialRotation struct {
+	platform string
+
+	// snapshot returns the current configuration, or nil if the platform is not configured.
+	snapshot func(ctx context.Context) (interface{}, error)
+
+	// save saves the new credentials and returns the saved configuration.
+	save func(ctx context.Context) (interface{}, error)
+
+	// restore saves the previous configuration again, or removes the configuration when previous is nil.
+	restore func(ctx context.Context, previous interface{}) error
+}
+
+// RotateGCMConf : Rotate the GCM credentials
+// Saves new GCM credentials and runs verify. If verification fails, the previous credentials are saved again, or the
+// GCM configuration is removed if there was none, and the verification error is returned.
+func (pushService *PushServiceV1) RotateGCMConf(saveGCMConfOptions *SaveGCMConfOptions, verify CredentialVerifier) (*CredentialRotationResult, error) {
+	return pushService.RotateGCMConfWithContext(context.Background(), saveGCMConfOptions, verify)
+}
+
+// RotateGCMConfWithContext is an alternate form of the RotateGCMConf method which supports a Context parameter
+func (pushService *PushServiceV1) RotateGCMConfWithContext(ctx context.Context, saveGCMConfOptions *SaveGCMConfOptions, verify CredentialVerifier) (*CredentialRotationResult, error) {
+	err := core.ValidateNotNil(saveGCMConfOptions, "saveGCMConfOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateStruct(saveGCMConfOptions, "saveGCMConfOptions")
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID := *saveGCMConfOptions.ApplicationID
+	return pushService.rotate(ctx, verify, credentialRotation{
+		platform: CredentialPlatform_Gcm,
+		snapshot: func(ctx context.Context) (interface{}, error) {
+			result, response, err := pushService.GetGCMConfWithContext(ctx, &GetGCMConfOptions{
+				ApplicationID:  &applicationID,
+				AcceptLanguage: saveGCMConfOptions.AcceptLanguage,
+				AppSecret:      saveGCMConfOptions.AppSecret,
+				Headers:        saveGCMConfOptions.Headers,
+			})
+			if isNotFound(response, err) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		save: func(ctx context.Context) (interface{}, error) {
+			result, _, err := pushService.SaveGCMConfWithContext(ctx, saveGCMConfOptions)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		restore: func(ctx context.Context, previous interface{}) error {
+			if previous == nil {
+				_, err := pushService.DeleteGCMConfWithContext(ctx, &DeleteGCMConfOptions{
+					ApplicationID:  &applicationID,
+					AcceptLanguage: saveGCMConfOptions.AcceptLanguage,
+					AppSecret:      saveGCMConfOptions.AppSecret,
+					Headers:        saveGCMConfOptions.Headers,
+				})
+				return err
+			}
+			previousConf := previous.(*GCMCredendialsModel)
+			_, _, err := pushService.SaveGCMConfWithContext(ctx, &SaveGCMConfOptions{
+				ApplicationID:  &applicationID,
+				ApiKey:         previousConf.ApiKey,
+				SenderID:       previousConf.SenderID,
+				AcceptLanguage: saveGCMConfOptions.AcceptLanguage,
+				AppSecret:      saveGCMConfOptions.AppSecret,
+				Headers:        saveGCMConfOptions.Headers,
+			})
+			return err
+		},
+	})
+}
+
+// RotateApnsConf : Rotate the APNs certificate
+// Saves a new APNs certificate and runs verify. Because the service does not return the certificate it holds, the
+// previous certificate must be supplied in previousApnsConfOptions to roll back to it; it may be nil only when APNs is
+// not configured yet, in which case a failed verification removes the APNs configuration.
+func (pushService *PushServiceV1) RotateApnsConf(saveApnsConfOptions *SaveApnsConfOptions, previousApnsConfOptions *SaveApnsConfOptions, verify CredentialVerifier) (*CredentialRotationResult, error) {
+	return pushService.RotateApnsConfWithContext(context.Background(), saveApnsConfOptions, previousApnsConfOptions, verify)
+}
+
+// RotateApnsConfWithContext is an alternate form of the RotateApnsConf method which supports a Context parameter
+func (pushService *PushServiceV1) RotateApnsConfWithContext(ctx context.Context, saveApnsConfOptions *SaveApnsConfOptions, previousApnsConfOptions *SaveApnsConfOptions, verify CredentialVerifier) (*CredentialRotationResult, error) {
+	err := core.ValidateNotNil(saveApnsConfOptions, "saveApnsConfOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateStruct(saveApnsConfOptions, "saveApnsConfOptions")
+	if err != nil {
+		return nil, err
+	}
+	if previousApnsConfOptions != nil {
+		err = core.ValidateStruct(previousApnsConfOptions, "previousApnsConfOptions")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	applicationID := *saveApnsConfOptions.ApplicationID
+	return pushService.rotate(ctx, verify, credentialRotation{
+		platform: CredentialPlatform_Apns,
+		snapshot: func(ctx context.Context) (interface{}, error) {
+			result, response, err := pushService.GetApnsConfWithContext(ctx, &GetApnsConfOptions{
+				ApplicationID:  &applicationID,
+				AcceptLanguage: saveApnsConfOptions.AcceptLanguage,
+				AppSecret:      saveApnsConfOptions.AppSecret,
+				Headers:        saveApnsConfOptions.Headers,
+			})
+			if isNotFound(response, err) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if previousApnsConfOptions == nil {
+				return nil, fmt.Errorf("apns: a certificate is configured, so the previous certificate is required to roll back")
+			}
+			return result, nil
+		},
+		save: func(ctx context.Context) (interface{}, error) {
+			result, _, err := pushService.SaveApnsConfWithContext(ctx, saveApnsConfOptions)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		restore: func(ctx context.Context, previous interface{}) error {
+			if previous == nil {
+				_, err := pushService.DeleteApnsConfWithContext(ctx, &DeleteApnsConfOptions{
+					ApplicationID:  &applicationID,
+					AcceptLanguage: saveApnsConfOptions.AcceptLanguage,
+					AppSecret:      saveApnsConfOptions.AppSecret,
+					Headers:        saveApnsConfOptions.Headers,
+				})
+				return err
+			}
+			// Restore the previous certificate even if it no longer passes inspection, such as when it has expired,
+			// which is often why it is being rotated.
+			restoreOptions := *previousApnsConfOptions
+			restoreOptions.Force = core.BoolPtr(true)
+			_, _, err := pushService.SaveApnsConfWithContext(ctx, &restoreOptions)
+			return err
+		},
+	})
+}
+
+// rotate snapshots the current configuration, saves the new credentials and verifies them, restoring the snapshot if
+// verification fails.
+func (pushService *PushServiceV1) rotate(ctx context.Context, verify CredentialVerifier, rotation credentialRotation) (result *CredentialRotationResult, err error) {
+	if verify == nil {
+		return nil, fmt.Errorf("%s: a verifier is required", rotation.platform)
+	}
+	result = &CredentialRotationResult{Platform: rotation.platform}
+
+	result.Previous, err = rotation.snapshot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to retrieve the current configuration: %s", rotation.platform, err.Error())
+	}
+
+	result.Saved, err = rotation.save(ctx)
+	if err != nil {
+		return result, fmt.Errorf("%s: unable to save the new credentials: %s", rotation.platform, err.Error())
+	}
+
+	result.VerificationError = verify(ctx)
+	if result.VerificationError == nil {
+		return result, nil
+	}
+
+	// The rollback must run even if ctx was cancelled while verifying, but not for ever.
+	timeout := pushService.rollbackTimeout
+	if timeout <= 0 {
+		timeout = DefaultCredentialRollbackTimeout
+	}
+	rollbackCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result.RollbackError = rotation.restore(rollbackCtx, result.Previous)
+	if result.RollbackError != nil {
+		return result, fmt.Errorf("%s: verification failed (%s) and the previous configuration could not be restored: %w",
+			rotation.platform, result.VerificationError.Error(), result.RollbackError)
+	}
+	result.RolledBack = true
+	return result, fmt.Errorf("%s: verification failed, the previous configuration was restored: %s",
+		rotation.platform, result.VerificationError.Error())
+}
+
+// NewCanaryVerifier returns a CredentialVerifier which sends a validate-only message to a canary device of the
+// application, so that the push service checks the credentials without notifying the device.
+func (pushService *PushServiceV1) NewCanaryVerifier(applicationID string, deviceID string) CredentialVerifier {
+	return func(ctx context.Context) error {
+		_, _, err := pushService.SendMessageWithContext(ctx, &SendMessageOptions{
+			ApplicationID: &applicationID,
+			Message:       &Message{Alert: core.StringPtr("Credential verification")},
+			Validate:      core.BoolPtr(true),
+			Target:        &Target{DeviceIds: []string{deviceID}},
+		})
+		return err
+	}
+}
+
+// isNotFound reports whether an operation failed because the resource does not exist.
+func isNotFound(response *core.DetailedResponse, err error) bool {
+	return err != nil && response != nil && response.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Credential rotation`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var requests []string
+	var gcmConf map[string]interface{}
+	var apnsConfigured bool
+	var apnsUploads int
+	var failSaves bool
+	var hangSaves chan struct{}
+	BeforeEach(func() {
+		requests = nil
+		gcmConf = map[string]interface{}{"apiKey": "OldApiKey", "senderId": "OldSenderID"}
+		apnsConfigured = true
+		apnsUploads = 0
+		failSaves = false
+		hangSaves = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			if hangSaves != nil && req.Method == "PUT" && req.Header.Get("X-Rollback") != "" {
+				<-hangSaves
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /apps/testString/settings/gcmConf":
+				if gcmConf == nil {
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+					return
+				}
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(gcmConf)).To(BeNil())
+			case "PUT /apps/testString/settings/gcmConf":
+				if failSaves && gcmConf["apiKey"] == "NewApiKey" {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, "%s", `{"message": "Internal error"}`)
+					return
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&gcmConf)).To(BeNil())
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(gcmConf)).To(BeNil())
+			case "DELETE /apps/testString/settings/gcmConf":
+				gcmConf = nil
+				res.WriteHeader(204)
+			case "GET /apps/testString/settings/apnsConf":
+				if !apnsConfigured {
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"certificate": "certificate.p12", "isSandBox": true}`)
+			case "PUT /apps/testString/settings/apnsConf":
+				_, _ = io.Copy(ioutil.Discard, req.Body)
+				apnsConfigured = true
+				apnsUploads++
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"certificate": "certificate.p12", "isSandBox": true}`)
+			case "DELETE /apps/testString/settings/apnsConf":
+				apnsConfigured = false
+				res.WriteHeader(204)
+			case "POST /apps/testString/messages":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(BeNil())
+				Expect(body["validate"]).To(Equal(true))
+				Expect(body["target"]).To(Equal(map[string]interface{}{"deviceIds": []interface{}{"canary"}}))
+				if gcmConf["apiKey"] == "NewApiKey" {
+					res.WriteHeader(400)
+					fmt.Fprintf(res, "%s", `{"message": "Invalid GCM credentials"}`)
+					return
+				}
+				res.WriteHeader(202)
+				fmt.Fprintf(res, "%s", `{"message": {"alert": "Credential verification"}}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newService := func() *pushservicev1.PushServiceV1 {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return pushServiceService
+	}
+	succeed := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("canary rejected") }
+
+	Describe(`RotateGCMConf(saveGCMConfOptions *SaveGCMConfOptions, verify CredentialVerifier)`, func() {
+		It(`Invoke RotateGCMConf with credentials that pass verification`, func() {
+			pushServiceService := newService()
+
+			result, err := pushServiceService.RotateGCMConf(
+				pushServiceService.NewSaveGCMConfOptions("testString", "GoodApiKey", "GoodSenderID"),
+				pushServiceService.NewCanaryVerifier("testString", "canary"))
+			Expect(err).To(BeNil())
+			Expect(result.Platform).To(Equal(pushservicev1.CredentialPlatform_Gcm))
+			Expect(result.Previous.(*pushservicev1.GCMCredendialsModel).ApiKey).To(Equal(core.StringPtr("OldApiKey")))
+			Expect(result.Saved.(*pushservicev1.GCMCredendialsModel).ApiKey).To(Equal(core.StringPtr("GoodApiKey")))
+			Expect(result.VerificationError).To(BeNil())
+			Expect(result.RolledBack).To(BeFalse())
+			Expect(gcmConf["apiKey"]).To(Equal("GoodApiKey"))
+		})
+		It(`Invoke RotateGCMConf with credentials that fail verification`, func() {
+			pushServiceService := newService()
+
+			result, err := pushServiceService.RotateGCMConf(
+				pushServiceService.NewSaveGCMConfOptions("testString", "NewApiKey", "NewSenderID"),
+				pushServiceService.NewCanaryVerifier("testString", "canary"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("Invalid GCM credentials"))
+			Expect(result.VerificationError).ToNot(BeNil())
+			Expect(result.RolledBack).To(BeTrue())
+			Expect(gcmConf).To(Equal(map[string]interface{}{"apiKey": "OldApiKey", "senderId": "OldSenderID"}))
+			Expect(requests).To(Equal([]string{
+				"GET /apps/testString/settings/gcmConf",
+				"PUT /apps/testString/settings/gcmConf",
+				"POST /apps/testString/messages",
+				"PUT /apps/testString/settings/gcmConf",
+			}))
+		})
+		It(`Invoke RotateGCMConf without a previous configuration`, func() {
+			pushServiceService := newService()
+			gcmConf = nil
+
+			result, err := pushServiceService.RotateGCMConf(
+				pushServiceService.NewSaveGCMConfOptions("testString", "NewApiKey", "NewSenderID"), fail)
+			Expect(err).ToNot(BeNil())
+			Expect(result.Previous).To(BeNil())
+			Expect(result.RolledBack).To(BeTrue())
+			Expect(gcmConf).To(BeNil())
+		})
+		It(`Invoke RotateGCMConf when the rollback fails`, func() {
+			pushServiceService := newService()
+			failSaves = true
+
+			result, err := pushServiceService.RotateGCMConf(
+				pushServiceService.NewSaveGCMConfOptions("testString", "NewApiKey", "NewSenderID"), fail)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("could not be restored"))
+			Expect(err.Error()).To(ContainSubstring("canary rejected"))
+			Expect(result.VerificationError).ToNot(BeNil())
+			Expect(result.RollbackError).ToNot(BeNil())
+			Expect(result.RolledBack).To(BeFalse())
+		})
+		It(`Invoke RotateGCMConf when the rollback does not complete`, func() {
+			hangSaves = make(chan struct{})
+			defer close(hangSaves)
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:                       testServer.URL,
+				Authenticator:             &core.NoAuthAuthenticator{},
+				CredentialRollbackTimeout: 100 * time.Millisecond,
+			})
+			Expect(serviceErr).To(BeNil())
+			saveGCMConfOptions := pushServiceService.NewSaveGCMConfOptions("testString", "NewApiKey", "NewSenderID")
+			verifyAndHang := func(ctx context.Context) error {
+				// Only the request restoring the previous configuration hangs.
+				pushServiceService.SetDefaultHeaders(http.Header{"X-Rollback": []string{"true"}})
+				return errors.New("canary rejected")
+			}
+
+			result, err := pushServiceService.RotateGCMConf(saveGCMConfOptions, verifyAndHang)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("canary rejected"))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(errors.Is(result.RollbackError, context.DeadlineExceeded)).To(BeTrue())
+			Expect(result.RolledBack).To(BeFalse())
+		})
+		It(`Invoke RotateGCMConf with error: Param validation error`, func() {
+			pushServiceService := newService()
+
+			result, err := pushServiceService.RotateGCMConf(nil, succeed)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			result, err = pushServiceService.RotateGCMConf(new(pushservicev1.SaveGCMConfOptions), succeed)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			result, err = pushServiceService.RotateGCMConf(
+				pushServiceService.NewSaveGCMConfOptions("testString", "NewApiKey", "NewSenderID"), nil)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	Describe(`RotateApnsConf(saveApnsConfOptions *SaveApnsConfOptions, previousApnsConfOptions *SaveApnsConfOptions, verify CredentialVerifier)`, func() {
+		It(`Invoke RotateApnsConf with a certificate that fails verification`, func() {
+			pushServiceService := newService()
+
+			result, err := pushServiceService.RotateApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, CreateMockReader("new")).SetForce(true),
+				pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, CreateMockReader("old")).SetForce(true),
+				fail)
+			Expect(err).ToNot(BeNil())
+			Expect(result.Previous.(*pushservicev1.ApnsCertUploadResponse).Certificate).To(Equal(core.StringPtr("certificate.p12")))
+			Expect(result.RolledBack).To(BeTrue())
+			Expect(apnsUploads).To(Equal(2))
+		})
+		It(`Invoke RotateApnsConf rolling back to an expired certificate`, func() {
+			pushServiceService := newService()
+			readFixture := func(name string) io.ReadCloser {
+				p12, err := ioutil.ReadFile(filepath.Join("testdata", name))
+				Expect(err).To(BeNil())
+				return ioutil.NopCloser(bytes.NewReader(p12))
+			}
+			previousApnsConfOptions := pushServiceService.NewSaveApnsConfOptions("testString", "secret", true, readFixture("apns_expired.p12"))
+
+			result, err := pushServiceService.RotateApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "secret", true, readFixture("apns_sandbox.p12")),
+				previousApnsConfOptions, fail)
+			Expect(err).ToNot(BeNil())
+			Expect(result.RollbackError).To(BeNil())
+			Expect(result.RolledBack).To(BeTrue())
+			Expect(apnsUploads).To(Equal(2))
+			Expect(previousApnsConfOptions.Force).To(BeNil())
+		})
+		It(`Invoke RotateApnsConf without a previous configuration`, func() {
+			pushServiceService := newService()
+			apnsConfigured = false
+
+			result, err := pushServiceService.RotateApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, CreateMockReader("new")).SetForce(true), nil, fail)
+			Expect(err).ToNot(BeNil())
+			Expect(result.RolledBack).To(BeTrue())
+			Expect(apnsConfigured).To(BeFalse())
+		})
+		It(`Invoke RotateApnsConf without the previous certificate`, func() {
+			pushServiceService := newService()
+
+			result, err := pushServiceService.RotateApnsConf(
+				pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, CreateMockReader("new")).SetForce(true), nil, succeed)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(apnsUploads).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The sentinel errors which a *PushError matches with errors.Is, according to its status code.
+var (
+	// ErrNotFound matches a 404 Not Found error.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized matches a 401 Unauthorized error: the credentials are missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrRateLimited matches a 429 Too Many Requests error.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrInvalidPayload matches a 400 Bad Request, 413 Request Entity Too Large or 422 Unprocessable Entity error:
+	// the service rejected the request body or parameters.
+	ErrInvalidPayload = errors.New("invalid payload")
+
+	// ErrConfigMissing matches a 404 Not Found error of a platform configuration read operation, such as GetGCMConf or
+	// GetGcmConfPublic, meaning the platform is not configured for the application. Such an error matches ErrNotFound
+	// too. A 404 error of GetSettings, for which the application itself was not found, does not match it.
+	ErrConfigMissing = errors.New("configuration missing")
+)
+
+// platformConfigPath matches the paths of the platform configurations, such as /apps/{applicationId}/settings/gcmConf
+// and /apps/{applicationId}/settings/gcmConfPublic.
+var platformConfigPath = regexp.MustCompile(`/apps/[^/]+/settings/[^/]+Conf(?:Public)?$`)
+
+// requestIDHeaders are the response headers which may carry the ID of a request, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Global-Transaction-Id"}
+
+// PushError : An error response of the Push Notifications service. Operations return it, as an error, for every
+// response whose status code is not 2xx; use errors.As to get it, or errors.Is with the sentinel errors above to test
+// its kind.
+type PushError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The error code of the service, such as FPWSE0001E, or "" when the response has none.
+	Code string
+
+	// The error message, from the response body or else the HTTP status text, with secrets redacted.
+	Message string
+
+	// The ID of the request, from the response headers or body, or "" when the response has none.
+	RequestID string
+
+	// The response. Its Result holds the decoded JSON body, or its RawResult the body of another type.
+	Response *core.DetailedResponse
+
+	// Whether the error is about a configuration, returned by a settings read operation.
+	configMissing bool
+}
+
+// Error returns the message of the error.
+func (e *PushError) Error() string {
+	return e.Message
+}
+
+// Is reports whether the error is of the kind of target, one of the sentinel errors.
+func (e *PushError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrInvalidPayload:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusRequestEntityTooLarge ||
+			e.StatusCode == http.StatusUnprocessableEntity
+	case ErrConfigMissing:
+		return e.StatusCode == http.StatusNotFound && e.configMissing
+	}
+	return false
+}
+
+// String returns a description of the error with its status code, error code and request ID.
+func (e *PushError) String() string {
+	description := fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+	if e.Code != "" {
+		description += fmt.Sprintf(" (code %s)", e.Code)
+	}
+	if e.RequestID != "" {
+		description += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return description
+}
+
+// newPushError returns a *PushError for err, the error returned by BaseService.Request with response, or nil if the
+// response is not an error response.
+func newPushError(request *http.Request, response *core.DetailedResponse, err error) *PushError {
+	if err == nil || response == nil || (response.StatusCode >= 200 && response.StatusCode < 300) {
+		return nil
+	}
+	pushError := &PushError{
+		StatusCode:    response.StatusCode,
+		Message:       RedactSecrets(err.Error()),
+		Response:      response,
+		configMissing: request.Method == http.MethodGet && platformConfigPath.MatchString(request.URL.EscapedPath()),
+	}
+	body, _ := response.Result.(map[string]interface{})
+	pushError.Code = stringField(body, "code", "errorCode")
+	for _, name := range requestIDHeaders {
+		if requestID := response.Headers.Get(name); requestID != "" {
+			pushError.RequestID = requestID
+			break
+		}
+	}
+	if pushError.RequestID == "" {
+		pushError.RequestID = stringField(body, "requestId", "trace")
+	}
+	return pushError
+}
+
+// stringField returns the first of the named fields of body which is a non-empty string, or "".
+func stringField(body map[string]interface{}, names ...string) string {
+	for _, name := range names {
+		if value, ok := body[name].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Invoker sends the request of an operation, named as in the SDK analytics header (such as SendMessage), and returns
+// its response. result is where the response body is decoded; interceptors should pass it through unchanged.
+type Invoker func(operation string, request *http.Request, result interface{}) (*core.DetailedResponse, error)
+
+// Interceptor : A middleware around the operations of a PushServiceV1. It receives each built request with the name of
+// its operation, and invokes next to send it. An interceptor may:
+//
+//   - observe or modify the request, such as adding headers, before invoking next;
+//   - observe or modify the response and the error returned by next, such as for audit logging;
+//   - short-circuit the request by returning without invoking next, such as for fault injection. The Result of the
+//     returned response, any value which marshals to JSON, or else its RawResult, a JSON body, is then decoded as the
+//     result of the operation.
+//
+// Error responses of the service reach the interceptors as a *PushError. Interceptors run for every operation,
+// including the settings reads answered by the configuration cache.
+type Interceptor func(operation string, request *http.Request, result interface{}, next Invoker) (*core.DetailedResponse, error)
+
+// AddInterceptors appends interceptors to the chain of the service. The first interceptor of the chain is the
+// outermost: it receives the request first and the response last. It is safe to call while other goroutines invoke
+// operations, which use the chain as it was when they started.
+func (pushService *PushServiceV1) AddInterceptors(interceptors ...Interceptor) {
+	if pushService.lock != nil {
+		pushService.lock.Lock()
+		defer pushService.lock.Unlock()
+	}
+	// Copy the chain, which running operations and clones may share.
+	chain := make([]Interceptor, 0, len(pushService.interceptors)+len(interceptors))
+	chain = append(chain, pushService.interceptors...)
+	pushService.interceptors = append(chain, interceptors...)
+}
+
+// request invokes the request of operation, built with service, through the interceptor chain.
+func (pushService *PushServiceV1) request(service *core.BaseService, operation string, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	interceptors := pushService.snapshot().interceptors
+	if len(interceptors) == 0 {
+		return pushService.send(service, operation, request, result)
+	}
+
+	sent := false
+	var invoke func(index int) Invoker
+	invoke = func(index int) Invoker {
+		if index == len(interceptors) {
+			return func(operation string, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+				sent = true
+				return pushService.send(service, operation, request, result)
+			}
+		}
+		return func(operation string, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+			return interceptors[index](operation, request, result, invoke(index+1))
+		}
+	}
+	response, err := invoke(0)(operation, request, result)
+	if err == nil && !sent {
+		err = decodeResult(response, result)
+	}
+	return response, err
+}
+
+// send invokes request with service, the BaseService it was built with, through the configuration cache, and redacts
+// secrets from the error, which may quote the response of the service. An error response is returned as a *PushError.
+// It is the Invoker at the end of the interceptor chain.
+func (pushService *PushServiceV1) send(service *core.BaseService, operation string, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	response, generation, hit := pushService.configCache.get(request, result)
+	if hit {
+		return response, nil
+	}
+	response, err := service.Request(request, result)
+	pushService.configCache.update(request, response, err, result, generation)
+	if pushError := newPushError(request, response, err); pushError != nil {
+		return response, pushError
+	}
+	return response, redactError(err)
+}
+
+// decodeResult decodes the body of a response returned by an interceptor which did not send the request into result.
+func decodeResult(response *core.DetailedResponse, result interface{}) error {
+	if response == nil || core.IsNil(result) {
+		return nil
+	}
+	body := response.RawResult
+	if response.Result != nil {
+		var err error
+		body, err = json.Marshal(response.Result)
+		if err != nil {
+			return fmt.Errorf("the result of the interceptor cannot be marshalled: %s", err.Error())
+		}
+	}
+	if body == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("the result of the interceptor cannot be decoded: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	var testServer *httptest.Server
+	var requests []*http.Request
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "POST /apps/testString/messages":
+				res.WriteHeader(202)
+				fmt.Fprintf(res, "%s", `{"messageId": "messageId"}`)
+			case "GET /apps/testString/settings":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"apnsConf": "apnsConf"}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"message": "Not found"}`)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newService := func(options *pushservicev1.PushServiceV1Options) *pushservicev1.PushServiceV1 {
+		options.URL = testServer.URL
+		options.Authenticator = &core.NoAuthAuthenticator{}
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(options)
+		Expect(serviceErr).To(BeNil())
+		return pushServiceService
+	}
+	sendMessage := func(pushServiceService *pushservicev1.PushServiceV1) (*pushservicev1.MessageResponseModel, *core.DetailedResponse, error) {
+		message := &pushservicev1.Message{Alert: core.StringPtr("Hello")}
+		return pushServiceService.SendMessage(pushServiceService.NewSendMessageOptions("testString", message))
+	}
+	It(`Run the chain in order around every operation`, func() {
+		var calls []string
+		tracer := func(name string) pushservicev1.Interceptor {
+			return func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+				calls = append(calls, name+" "+operation)
+				request.Header.Add("X-Trace", name)
+				response, err := next(operation, request, result)
+				calls = append(calls, fmt.Sprintf("%s %d", name, response.StatusCode))
+				return response, err
+			}
+		}
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			Interceptors: []pushservicev1.Interceptor{tracer("outer")},
+		})
+		pushServiceService.AddInterceptors(tracer("inner"))
+
+		result, response, err := sendMessage(pushServiceService)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*result.MessageID).To(Equal("messageId"))
+		_, _, err = pushServiceService.GetApnsConf(pushServiceService.NewGetApnsConfOptions("testString"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(calls).To(Equal([]string{
+			"outer SendMessage", "inner SendMessage", "inner 202", "outer 202",
+			"outer GetApnsConf", "inner GetApnsConf", "inner 404", "outer 404",
+		}))
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header["X-Trace"]).To(Equal([]string{"outer", "inner"}))
+	})
+	It(`Observe typed errors`, func() {
+		var observed error
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			Interceptors: []pushservicev1.Interceptor{
+				func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+					response, err := next(operation, request, result)
+					observed = err
+					return response, err
+				},
+			},
+		})
+		_, _, err := pushServiceService.GetGCMConf(pushServiceService.NewGetGCMConfOptions("testString"))
+		Expect(err).To(Equal(observed))
+		Expect(errors.Is(observed, pushservicev1.ErrConfigMissing)).To(BeTrue())
+	})
+	It(`Short-circuit requests`, func() {
+		injected := errors.New("injected fault")
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			Interceptors: []pushservicev1.Interceptor{
+				func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+					switch operation {
+					case "SendMessage":
+						return &core.DetailedResponse{
+							StatusCode: 202,
+							Result:     map[string]interface{}{"messageId": "stubbed"},
+						}, nil
+					case "GetSettings":
+						return &core.DetailedResponse{StatusCode: 200, RawResult: []byte(`{"gcmConf": "gcmConf"}`)}, nil
+					case "GetApnsConf":
+						return &core.DetailedResponse{StatusCode: 503}, injected
+					}
+					return next(operation, request, result)
+				},
+			},
+		})
+
+		result, response, err := sendMessage(pushServiceService)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*result.MessageID).To(Equal("stubbed"))
+
+		settings, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(*settings.GcmConf).To(Equal("gcmConf"))
+
+		_, response, err = pushServiceService.GetApnsConf(pushServiceService.NewGetApnsConfOptions("testString"))
+		Expect(err).To(Equal(injected))
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Intercept reads answered by the configuration cache`, func() {
+		operations := 0
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			ConfigCacheTTL: time.Minute,
+			Interceptors: []pushservicev1.Interceptor{
+				func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+					operations++
+					return next(operation, request, result)
+				},
+			},
+		})
+		for i := 0; i < 2; i++ {
+			settings, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+			Expect(err).To(BeNil())
+			Expect(*settings.ApnsConf).To(Equal("apnsConf"))
+		}
+		Expect(operations).To(Equal(2))
+		Expect(requests).To(HaveLen(1))
+	})
+	It(`Keep the chain of a clone separate`, func() {
+		var calls []string
+		recorder := func(name string) pushservicev1.Interceptor {
+			return func(operation string, request *http.Request, result interface{}, next pushservicev1.Invoker) (*core.DetailedResponse, error) {
+				calls = append(calls, name)
+				return next(operation, request, result)
+			}
+		}
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			Interceptors: []pushservicev1.Interceptor{recorder("shared")},
+		})
+		clone := pushServiceService.Clone()
+		clone.AddInterceptors(recorder("clone"))
+
+		_, _, err := sendMessage(pushServiceService)
+		Expect(err).To(BeNil())
+		_, _, err = sendMessage(clone)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"shared", "shared", "clone"}))
+	})
+})
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Model unmarshal tests`, func() {
+	Describe(`UnmarshalGcm(m map[string]json.RawMessage, result interface{})`, func() {
+		It(`Unmarshals the extended Android fields`, func() {
+			var raw map[string]json.RawMessage
+			err := json.Unmarshal([]byte(`{"visibility": "secret", "priority": "max", "color": "#ff0000", "imageUrl": "ImageURL", "clickAction": "ClickAction", "tag": "Tag", "ticker": "Ticker", "notificationCount": 3, "sticky": true, "localOnly": true, "vibrationPattern": [0, 250, 250, 250]}`), &raw)
+			Expect(err).To(BeNil())
+
+			var result *pushservicev1.Gcm
+			err = pushservicev1.UnmarshalGcm(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result.Visibility).To(Equal(core.StringPtr(pushservicev1.Gcm_Visibility_Secret)))
+			Expect(result.Priority).To(Equal(core.StringPtr(pushservicev1.Gcm_Priority_Max)))
+			Expect(result.Color).To(Equal(core.StringPtr("#ff0000")))
+			Expect(result.ImageURL).To(Equal(core.StringPtr("ImageURL")))
+			Expect(result.ClickAction).To(Equal(core.StringPtr("ClickAction")))
+			Expect(result.Tag).To(Equal(core.StringPtr("Tag")))
+			Expect(result.Ticker).To(Equal(core.StringPtr("Ticker")))
+			Expect(result.NotificationCount).To(Equal(core.Int64Ptr(int64(3))))
+			Expect(result.Sticky).To(Equal(core.BoolPtr(true)))
+			Expect(result.LocalOnly).To(Equal(core.BoolPtr(true)))
+			Expect(result.VibrationPattern).To(Equal([]int64{0, 250, 250, 250}))
+		})
+	})
+	Describe(`UnmarshalChromeWeb(m map[string]json.RawMessage, result interface{})`, func() {
+		It(`Unmarshals the notification options and Web Push headers`, func() {
+			var raw map[string]json.RawMessage
+			err := json.Unmarshal([]byte(`{"title": "Title", "actions": [{"action": "Action", "title": "Title", "iconUrl": "IconURL"}], "badgeUrl": "BadgeURL", "imageUrl": "ImageURL", "body": "Body", "requireInteraction": true, "silent": false, "vibrate": [100, 50], "tag": "Tag", "renotify": true, "urgency": "low", "topic": "Topic"}`), &raw)
+			Expect(err).To(BeNil())
+
+			var result *pushservicev1.ChromeWeb
+			err = pushservicev1.UnmarshalChromeWeb(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result.Actions).To(HaveLen(1))
+			Expect(result.Actions[0].Action).To(Equal(core.StringPtr("Action")))
+			Expect(result.Actions[0].Title).To(Equal(core.StringPtr("Title")))
+			Expect(result.Actions[0].IconURL).To(Equal(core.StringPtr("IconURL")))
+			Expect(result.BadgeURL).To(Equal(core.StringPtr("BadgeURL")))
+			Expect(result.ImageURL).To(Equal(core.StringPtr("ImageURL")))
+			Expect(result.Body).To(Equal(core.StringPtr("Body")))
+			Expect(result.RequireInteraction).To(Equal(core.BoolPtr(true)))
+			Expect(result.Silent).To(Equal(core.BoolPtr(false)))
+			Expect(result.Vibrate).To(Equal([]int64{100, 50}))
+			Expect(result.Tag).To(Equal(core.StringPtr("Tag")))
+			Expect(result.Renotify).To(Equal(core.BoolPtr(true)))
+			Expect(result.Urgency).To(Equal(core.StringPtr(pushservicev1.ChromeWeb_Urgency_Low)))
+			Expect(result.Topic).To(Equal(core.StringPtr("Topic")))
+		})
+	})
+	Describe(`UnmarshalFirefoxWeb(m map[string]json.RawMessage, result interface{})`, func() {
+		It(`Unmarshals the notification options and Web Push headers`, func() {
+			var raw map[string]json.RawMessage
+			err := json.Unmarshal([]byte(`{"actions": [{"action": "Action", "title": "Title"}], "urgency": "normal", "topic": "Topic"}`), &raw)
+			Expect(err).To(BeNil())
+
+			var result *pushservicev1.FirefoxWeb
+			err = pushservicev1.UnmarshalFirefoxWeb(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result.Actions).To(HaveLen(1))
+			Expect(result.Urgency).To(Equal(core.StringPtr(pushservicev1.FirefoxWeb_Urgency_Normal)))
+			Expect(result.Topic).To(Equal(core.StringPtr("Topic")))
+		})
+	})
+})
+
+var _ = Describe(`Model additional properties tests`, func() {
+	// roundTrip unmarshals doc with the given unmarshaler and marshals the result again.
+	roundTrip := func(doc string, unmarshaler core.ModelUnmarshaller, result interface{}) []byte {
+		var raw map[string]json.RawMessage
+		err := json.Unmarshal([]byte(doc), &raw)
+		Expect(err).To(BeNil())
+		err = core.UnmarshalModel(raw, "", result, unmarshaler)
+		Expect(err).To(BeNil())
+		buffer, err := json.Marshal(result)
+		Expect(err).To(BeNil())
+		return buffer
+	}
+	It(`Round-trips unknown fields of MessageResponseModel at every level`, func() {
+		doc := `{"message":{"message":{"alert":"Alert","sound":"default"},"settings":{"apns":{"badge":1,"futureApnsField":{"nested":[1,2.50,null]}},"gcm":{"futureGcmField":9007199254740993},"unknownPlatform":{"enabled":true}},"target":{"futureTarget":["a"],"userIds":["UserID"]},"validate":true},"messageId":"MessageID","status":"queued"}`
+
+		var result *pushservicev1.MessageResponseModel
+		Expect(string(roundTrip(doc, pushservicev1.UnmarshalMessageResponseModel, &result))).To(Equal(doc))
+		Expect(result.MessageID).To(Equal(core.StringPtr("MessageID")))
+		Expect(result.GetProperty("status")).To(Equal("queued"))
+		Expect(result.Message.Message.GetProperty("sound")).To(Equal("default"))
+		Expect(result.Message.Settings.Gcm.GetProperty("futureGcmField")).To(Equal(json.Number("9007199254740993")))
+		Expect(result.Message.Settings.Apns.GetProperties()).To(HaveKey("futureApnsField"))
+		Expect(result.Message.Settings.Apns.GetProperties()).ToNot(HaveKey("badge"))
+	})
+	It(`Round-trips unknown fields of the configuration responses`, func() {
+		doc := `{"certificate":"Certificate","urlFormatString":"https://example.com/%@","webSiteUrl":"https://example.com","websiteName":"WebsiteName","websitePushID":"web.com.example","websitePushIconSet":{"16x16":"icon.png"}}`
+		var safariResult *pushservicev1.SafariCertUploadResponse
+		Expect(string(roundTrip(doc, pushservicev1.UnmarshalSafariCertUploadResponse, &safariResult))).To(Equal(doc))
+
+		doc = `{"apnsConf":"ApnsConf","chromeAppExtConf":"ChromeAppExtConf","gcmConf":"GcmConf"}`
+		var settingsResult *pushservicev1.AppSettingsObjResponse
+		Expect(string(roundTrip(doc, pushservicev1.UnmarshalAppSettingsObjResponse, &settingsResult))).To(Equal(doc))
+		Expect(settingsResult.GetProperty("chromeAppExtConf")).To(Equal("ChromeAppExtConf"))
+
+		doc = `{"apiKey":"ApiKey","projectId":"ProjectID","senderId":"SenderID"}`
+		var gcmResult *pushservicev1.GCMCredendialsModel
+		Expect(string(roundTrip(doc, pushservicev1.UnmarshalGCMCredendialsModel, &gcmResult))).To(Equal(doc))
+	})
+	It(`Round-trips unknown fields of the web push settings`, func() {
+		doc := `{"chromeWeb":{"actions":[{"action":"Action","futureActionField":1,"title":"Title"}],"title":"Title"},"firefoxWeb":{"dir":"rtl","title":"Title"},"safariWeb":{"title":"Title","urgency":"high","urlArgs":["a"]}}`
+		var result *pushservicev1.Settings
+		Expect(string(roundTrip(doc, pushservicev1.UnmarshalSettings, &result))).To(Equal(doc))
+		Expect(result.ChromeWeb.Actions[0].GetProperty("futureActionField")).To(Equal(json.Number("1")))
+	})
+	It(`Marshals properties set by the user`, func() {
+		message := &pushservicev1.Message{Alert: core.StringPtr("Alert")}
+		message.SetProperty("sound", "default")
+		// A declared field always takes precedence over a property with the same name.
+		message.SetProperty("alert", "Ignored")
+
+		buffer, err := json.Marshal(message)
+		Expect(err).To(BeNil())
+		Expect(string(buffer)).To(Equal(`{"alert":"Alert","sound":"default"}`))
+
+		message.SetProperties(map[string]interface{}{"priority": 5})
+		Expect(message.GetProperty("sound")).To(BeNil())
+		buffer, err = json.Marshal(message)
+		Expect(err).To(BeNil())
+		Expect(string(buffer)).To(Equal(`{"alert":"Alert","priority":5}`))
+
+		buffer, err = json.Marshal(&pushservicev1.Message{})
+		Expect(err).To(BeNil())
+		Expect(string(buffer)).To(Equal(`{}`))
+	})
+})
@@ -0,0 +1,318 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// sniffLength is the number of bytes http.DetectContentType considers.
+const sniffLength = 512
+
+// sniffedDefaultContentType is the content type http.DetectContentType returns when it does not recognize the content.
+const sniffedDefaultContentType = "application/octet-stream"
+
+// multipartPart is one part of a multipart/form-data request body: either a plain value, or a file when file is set.
+type multipartPart struct {
+	fieldName string
+	value     string
+
+	file     io.Reader
+	fileName string
+
+	// The content type given by the user. When nil, the content type is sniffed from the first bytes of the file, and
+	// defaultContentType is used if they are not recognized.
+	contentType        *string
+	defaultContentType string
+
+	// The content type sent, resolved by prepare.
+	resolvedContentType string
+
+	// The file as a seeker, and its offset when the body was created, or nil if the file is not seekable.
+	seeker io.Seeker
+	start  int64
+}
+
+// newMultipartFilePart returns the part for a file upload, or nil if the file is not set.
+func newMultipartFilePart(fieldName string, fileName string, file io.ReadCloser, contentType *string, defaultContentType string) *multipartPart {
+	if file == nil {
+		return nil
+	}
+	return &multipartPart{
+		fieldName:          fieldName,
+		file:               file,
+		fileName:           fileName,
+		contentType:        contentType,
+		defaultContentType: defaultContentType,
+	}
+}
+
+// multipartBody is a multipart/form-data request body which writes its parts, in order, as it is read, so that files
+// are streamed to the service rather than held in memory.
+//
+// When every file is seekable, such as an *os.File, the length of the body is known: the request is sent with a
+// Content-Length, and the body is read again from the start of the files when the request is retried or redirected.
+// Otherwise the request is sent chunked, and cannot be sent again.
+type multipartBody struct {
+	parts       []*multipartPart
+	boundary    string
+	contentType string
+
+	// The length of the body, or -1 if a file is not seekable.
+	length int64
+
+	// Guards stream and done.
+	lock sync.Mutex
+
+	// The stream being read, started by the first Read, and closed once its writer has returned.
+	stream *io.PipeReader
+	done   chan struct{}
+}
+
+// newMultipartBody returns a multipart/form-data body holding the parts, in order. Nil parts are skipped. The start of
+// every file is read to resolve its content type, and seekable files are measured; their content is only read as the
+// body is. The body must be closed once the request has completed, which stops the writer if the body was not read to
+// the end.
+func newMultipartBody(parts ...*multipartPart) (body *multipartBody, err error) {
+	formWriter := multipart.NewWriter(ioutil.Discard)
+	body = &multipartBody{
+		boundary:    formWriter.Boundary(),
+		contentType: formWriter.FormDataContentType(),
+	}
+	var filesLength int64
+	for _, part := range parts {
+		if part == nil {
+			continue
+		}
+		body.parts = append(body.parts, part)
+		if part.file == nil {
+			continue
+		}
+		size, err := part.prepare()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %s", part.fieldName, err.Error())
+		}
+		if size < 0 || filesLength < 0 {
+			filesLength = -1
+		} else {
+			filesLength += size
+		}
+	}
+
+	body.length = -1
+	if filesLength >= 0 {
+		// Measure the body without the files.
+		counter := new(countingWriter)
+		if err = body.write(counter, false); err != nil {
+			return nil, err
+		}
+		body.length = counter.count + filesLength
+	}
+	return body, nil
+}
+
+// prepare resolves the content type of the file part and returns the size of the file, or -1 if it is not seekable.
+func (part *multipartPart) prepare() (size int64, err error) {
+	if seeker, ok := part.file.(io.Seeker); ok {
+		// Some files, such as pipes, are seekers which fail to seek.
+		if part.start, err = seeker.Seek(0, io.SeekCurrent); err == nil {
+			part.seeker = seeker
+		}
+	}
+
+	part.resolvedContentType = ""
+	if part.contentType != nil {
+		part.resolvedContentType = *part.contentType
+	}
+	if part.resolvedContentType == "" {
+		start := make([]byte, sniffLength)
+		n, err := io.ReadFull(part.file, start)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		part.resolvedContentType = http.DetectContentType(start[:n])
+		if part.resolvedContentType == sniffedDefaultContentType && part.defaultContentType != "" {
+			part.resolvedContentType = part.defaultContentType
+		}
+		if part.seeker == nil {
+			// Put the start of the file back in front of the rest.
+			part.file = io.MultiReader(bytes.NewReader(start[:n]), part.file)
+		} else if _, err = part.seeker.Seek(part.start, io.SeekStart); err != nil {
+			return 0, err
+		}
+	}
+
+	if part.seeker == nil {
+		return -1, nil
+	}
+	end, err := part.seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = part.seeker.Seek(part.start, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return end - part.start, nil
+}
+
+// write writes the body to writer. When files is false, the content of the files is left out, to measure the rest.
+func (body *multipartBody) write(writer io.Writer, files bool) error {
+	formWriter := multipart.NewWriter(writer)
+	if err := formWriter.SetBoundary(body.boundary); err != nil {
+		return err
+	}
+	for _, part := range body.parts {
+		header := make(textproto.MIMEHeader)
+		if part.file == nil {
+			header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, escapeQuotes(part.fieldName)))
+			partWriter, err := formWriter.CreatePart(header)
+			if err != nil {
+				return err
+			}
+			if _, err = io.WriteString(partWriter, part.value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeQuotes(part.fieldName), escapeQuotes(part.fileName)))
+		header.Set("Content-Type", part.resolvedContentType)
+		partWriter, err := formWriter.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if files {
+			if _, err = io.Copy(partWriter, part.file); err != nil {
+				return fmt.Errorf("unable to read %s: %s", part.fieldName, err.Error())
+			}
+		}
+	}
+	return formWriter.Close()
+}
+
+// Read reads the body, starting the writer of its stream on the first call.
+func (body *multipartBody) Read(p []byte) (int, error) {
+	body.lock.Lock()
+	if body.stream == nil {
+		reader, writer := io.Pipe()
+		done := make(chan struct{})
+		body.stream, body.done = reader, done
+		go func() {
+			defer close(done)
+			writer.CloseWithError(body.write(writer, true))
+		}()
+	}
+	stream := body.stream
+	body.lock.Unlock()
+	return stream.Read(p)
+}
+
+// Close stops the stream being read, if any.
+func (body *multipartBody) Close() error {
+	body.lock.Lock()
+	defer body.lock.Unlock()
+	if body.stream != nil {
+		return body.stream.Close()
+	}
+	return nil
+}
+
+// rewind stops the stream being read, if any, and seeks the files back to their start, so that the next Read starts
+// the body over. It fails if a file is not seekable.
+func (body *multipartBody) rewind() error {
+	if body.length < 0 {
+		return errors.New("the multipart body cannot be read again, as a file it holds is not seekable")
+	}
+	body.lock.Lock()
+	defer body.lock.Unlock()
+	if body.stream != nil {
+		body.stream.Close()
+		// Wait for the writer to stop reading the files.
+		<-body.done
+		body.stream, body.done = nil, nil
+	}
+	for _, part := range body.parts {
+		if part.seeker != nil {
+			if _, err := part.seeker.Seek(part.start, io.SeekStart); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// requestBody returns the body to send. When its files are seekable, it is also an io.Seeker, so that a retrying
+// client, such as go-retryablehttp, rewinds it for a retry rather than reading it into memory.
+func (body *multipartBody) requestBody() io.ReadCloser {
+	if body.length >= 0 {
+		return seekableMultipartBody{body}
+	}
+	return body
+}
+
+// prepareRequest sets the Content-Length of request, and lets it be sent again, if the length of the body is known and
+// the body was sent as it is rather than compressed.
+func (body *multipartBody) prepareRequest(request *http.Request) {
+	if body.length < 0 || request.Header.Get("Content-Encoding") != "" {
+		return
+	}
+	request.ContentLength = body.length
+	request.GetBody = func() (io.ReadCloser, error) {
+		if err := body.rewind(); err != nil {
+			return nil, err
+		}
+		return body.requestBody(), nil
+	}
+}
+
+// seekableMultipartBody is a multipartBody whose files are all seekable. It only seeks to the start of the body.
+type seekableMultipartBody struct {
+	*multipartBody
+}
+
+func (body seekableMultipartBody) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("the multipart body can only be read again from its start")
+	}
+	return 0, body.rewind()
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	count int64
+}
+
+func (writer *countingWriter) Write(p []byte) (int, error) {
+	writer.count += int64(len(p))
+	return len(p), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
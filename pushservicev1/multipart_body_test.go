@@ -0,0 +1,298 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// uploadedPart is a part of a multipart request received by the mock server.
+type uploadedPart struct {
+	fieldName   string
+	fileName    string
+	contentType string
+	size        int64
+}
+
+// failingReader returns an error once the first few bytes have been read.
+type failingReader struct {
+	read bool
+}
+
+func (reader *failingReader) Read(p []byte) (int, error) {
+	if reader.read {
+		return 0, errors.New("read failure")
+	}
+	reader.read = true
+	return copy(p, "partial"), nil
+}
+
+func (reader *failingReader) Close() error {
+	return nil
+}
+
+var _ = Describe(`Multipart uploads`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var requests [][]uploadedPart
+	var chunked bool
+	var failures int
+	BeforeEach(func() {
+		requests = nil
+		chunked = false
+		failures = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("PUT"))
+			mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			Expect(err).To(BeNil())
+			Expect(mediaType).To(Equal("multipart/form-data"))
+
+			var parts []uploadedPart
+			reader := multipart.NewReader(req.Body, params["boundary"])
+			for {
+				part, err := reader.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					res.WriteHeader(400)
+					return
+				}
+				size, _ := io.Copy(ioutil.Discard, part)
+				parts = append(parts, uploadedPart{part.FormName(), part.FileName(), part.Header.Get("Content-Type"), size})
+			}
+
+			mutex.Lock()
+			requests = append(requests, parts)
+			chunked = req.ContentLength == -1
+			fail := failures > 0
+			failures--
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			if fail {
+				res.WriteHeader(503)
+				fmt.Fprintf(res, "%s", `{"message": "Unavailable"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"certificate": "Certificate"}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newService := func() *pushservicev1.PushServiceV1 {
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return pushServiceService
+	}
+	newPNG := func(size int) io.ReadCloser {
+		var buffer bytes.Buffer
+		Expect(png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, size, size)))).To(BeNil())
+		return ioutil.NopCloser(&buffer)
+	}
+
+	It(`Invoke SaveSafariWebConf with named, typed parts in a fixed order`, func() {
+		pushServiceService := newService()
+		options := pushServiceService.NewSaveSafariWebConfOptions("testString", "testString",
+			ioutil.NopCloser(bytes.NewReader([]byte{0x30, 0x82, 0x0a, 0x01, 0x02, 0x01, 0x03})),
+			"testString", "https://example.com/%@", "web.com.example", "https://example.com").
+			SetIcon16x16(newPNG(16)).
+			SetIcon128x1282x(newPNG(256)).
+			SetIcon128x1282xContentType("image/x-custom")
+
+		_, _, err := pushServiceService.SaveSafariWebConf(options)
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]).To(HaveLen(8))
+		Expect(requests[0][0].fieldName).To(Equal("password"))
+		Expect(requests[0][1]).To(Equal(uploadedPart{"certificate", "certificate.p12", "application/x-pkcs12", 7}))
+		Expect(requests[0][2].fieldName).To(Equal("websiteName"))
+		Expect(requests[0][5].fieldName).To(Equal("webSiteUrl"))
+		Expect(requests[0][6].fieldName).To(Equal("icon_16x16"))
+		Expect(requests[0][6].fileName).To(Equal("icon_16x16.png"))
+		Expect(requests[0][6].contentType).To(Equal("image/png"))
+		Expect(requests[0][7].fileName).To(Equal("icon_128x128@2x.png"))
+		Expect(requests[0][7].contentType).To(Equal("image/x-custom"))
+	})
+	It(`Invoke SaveApnsConf with a large certificate`, func() {
+		pushServiceService := newService()
+		// A certificate larger than any internal buffer, which is not seekable, so is sent without a Content-Length.
+		certificate := ioutil.NopCloser(io.LimitReader(zeroReader{}, 8<<20))
+
+		_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, certificate).SetForce(true))
+		Expect(err).To(BeNil())
+		Expect(chunked).To(BeTrue())
+		Expect(requests[0]).To(HaveLen(3))
+		Expect(requests[0][2]).To(Equal(uploadedPart{"certificate", "certificate.p12", "application/x-pkcs12", 8 << 20}))
+	})
+	It(`Invoke SaveApnsConf with a large seekable certificate`, func() {
+		pushServiceService := newService()
+		certificate := &zeroFile{size: 8 << 20}
+
+		_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions("testString", "testString", true, certificate).SetForce(true))
+		Expect(err).To(BeNil())
+		Expect(chunked).To(BeFalse())
+		Expect(requests[0][2]).To(Equal(uploadedPart{"certificate", "certificate.p12", "application/x-pkcs12", 8 << 20}))
+	})
+	It(`Invoke SaveApnsConf concurrently`, func() {
+		pushServiceService := newService()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+					"testString", "testString", true, CreateMockReader("This is a mock file.")).SetForce(true))
+				Expect(err).To(BeNil())
+			}()
+		}
+		wg.Wait()
+		Expect(requests).To(HaveLen(10))
+		for _, parts := range requests {
+			Expect(parts[2].fileName).To(Equal("certificate.p12"))
+			Expect(parts[2].contentType).To(Equal("text/plain; charset=utf-8"))
+		}
+	})
+	It(`Invoke SaveApnsConf with retries enabled`, func() {
+		pushServiceService := newService()
+		pushServiceService.EnableRetries(1, 10*time.Millisecond)
+		failures = 1
+		certificate := &zeroFile{size: 1 << 20}
+
+		_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+			"testString", "testString", true, certificate).SetForce(true))
+		Expect(err).To(BeNil())
+		Expect(chunked).To(BeFalse())
+		Expect(requests).To(HaveLen(2))
+		for _, parts := range requests {
+			Expect(parts[2].size).To(Equal(int64(1 << 20)))
+		}
+		// The certificate was read again from its start rather than buffered.
+		Expect(certificate.read).To(Equal(int64(2<<20 + 512)))
+	})
+	It(`Stream a certificate without buffering it`, func() {
+		const size = 64 << 20
+		certificate := &zeroFile{size: size}
+		var readWhenReceived int64
+		streamServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			_, err := io.CopyN(ioutil.Discard, req.Body, 1<<20)
+			Expect(err).To(BeNil())
+			readWhenReceived = atomic.LoadInt64(&certificate.read)
+			_, _ = io.Copy(ioutil.Discard, req.Body)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"certificate": "Certificate"}`)
+		}))
+		defer streamServer.Close()
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			URL:           streamServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		for _, file := range []io.ReadCloser{certificate, ioutil.NopCloser(certificate)} {
+			Expect(certificate.Seek(0, io.SeekStart)).To(BeZero())
+			atomic.StoreInt64(&certificate.read, 0)
+			_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+				"testString", "testString", true, file).SetForce(true))
+			Expect(err).To(BeNil())
+			Expect(readWhenReceived).To(BeNumerically("<", size/4))
+			Expect(atomic.LoadInt64(&certificate.read)).To(BeNumerically(">=", size))
+		}
+	})
+	It(`Invoke SaveApnsConf with a certificate that cannot be read`, func() {
+		pushServiceService := newService()
+
+		_, _, err := pushServiceService.SaveApnsConf(pushServiceService.NewSaveApnsConfOptions(
+			"testString", "testString", true, &failingReader{}).SetForce(true))
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+// zeroFile is a seekable file of zero bytes, which counts the bytes read from it.
+type zeroFile struct {
+	size   int64
+	offset int64
+	read   int64
+}
+
+func (file *zeroFile) Read(p []byte) (int, error) {
+	if file.offset >= file.size {
+		return 0, io.EOF
+	}
+	if remaining := file.size - file.offset; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	file.offset += int64(len(p))
+	atomic.AddInt64(&file.read, int64(len(p)))
+	return len(p), nil
+}
+
+func (file *zeroFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += file.offset
+	case io.SeekEnd:
+		offset += file.size
+	}
+	file.offset = offset
+	return offset, nil
+}
+
+func (file *zeroFile) Close() error {
+	return nil
+}
+
+// zeroReader is an endless source of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
@@ -23,12 +23,15 @@ package pushservicev1
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/rand"
 	"net/http"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -39,8 +42,38 @@ import (
 // https://github.com/openapitools/openapi-generator)
 //
 // Version: 1.0
+//
+// A PushServiceV1 is safe for concurrent use, including its configuration methods, which replace Service with a
+// reconfigured copy rather than modifying it.
 type PushServiceV1 struct {
+	// The BaseService the operations are sent with.
+	//
+	// Deprecated: Reading or modifying Service directly is not synchronized with the operations and the configuration
+	// methods, and a modification may be lost when one of them replaces it. Use SetServiceURL, SetDefaultHeaders,
+	// SetEnableGzipCompression, EnableRetries and DisableRetries to reconfigure the service, and Clone to derive a
+	// differently configured one.
 	Service *core.BaseService
+
+	// Guards Service, which the configuration methods replace with a reconfigured copy.
+	lock *sync.RWMutex
+
+	// The HTTP client of the service before retries are enabled, which EnableRetries wraps and DisableRetries restores.
+	httpClient *http.Client
+
+	// When true, SendMessage checks SafariWeb.UrlArgs against the urlFormatString of the Safari configuration.
+	validateSafariURLArgs bool
+
+	// Safari urlFormatStrings fetched for urlArgs validation, keyed by application ID.
+	safariURLFormats *safariURLFormatCache
+
+	// Recent results of the settings read operations, when PushServiceV1Options.ConfigCacheTTL is set.
+	configCache *configCache
+
+	// The interceptor chain of the operations, replaced rather than modified by AddInterceptors.
+	interceptors []Interceptor
+
+	// How long the rollback of a credential rotation may take, or zero for DefaultCredentialRollbackTimeout.
+	rollbackTimeout time.Duration
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -54,20 +87,58 @@ type PushServiceV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
-}
 
-const charset = "abcdefghijklmnopqrstuvwxyz_-" +
-	"ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// The region of the push service instance, such as us-south, or private.us-south for its private endpoint. The
+	// service URL is resolved with GetServiceURLForRegion. Region and URL are mutually exclusive.
+	Region string
 
-var seededRand *rand.Rand = rand.New(
-	rand.NewSource(time.Now().UnixNano()))
+	// When true, SendMessage and SendMessagesInBulk fetch (and cache) the application's Safari configuration and reject
+	// a message whose SafariWeb.UrlArgs does not match the number of %@ placeholders in the configured urlFormatString.
+	ValidateSafariURLArgs bool
 
-func stringWithCharset(length int) string {
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[seededRand.Intn(len(charset))]
-	}
-	return string(b)
+	// The HTTP client of the service. When nil, the go-sdk-core default client is used. The client is copied, so the
+	// options below do not modify it.
+	HTTPClient *http.Client
+
+	// The transport of the HTTP client, replacing the transport of HTTPClient.
+	Transport http.RoundTripper
+
+	// The URL of the proxy requests are sent through. When empty, the proxy is taken from the environment
+	// (HTTPS_PROXY, NO_PROXY, ...), as by default.
+	ProxyURL string
+
+	// The client certificates presented to the service, for mutual TLS.
+	ClientCertificates []tls.Certificate
+
+	// The minimum TLS version accepted, such as tls.VersionTLS12. When zero, the Go default is used.
+	MinTLSVersion uint16
+
+	// The base64-encoded SHA-256 hashes of the public keys (SubjectPublicKeyInfo) the service endpoint may present. When
+	// set, the connection fails unless one of the certificates presented by the service has one of them.
+	PinnedPublicKeys []string
+
+	// The redirect policy of the HTTP client, such as NoRedirects. When nil, the net/http default policy is used.
+	CheckRedirect func(req *http.Request, via []*http.Request) error
+
+	// The maximum size, in bytes, of a response body. A larger response fails. When zero, the size is not limited.
+	MaxResponseBodySize int64
+
+	// When positive, the results of GetSettings and of the Get operations of the platform configurations are cached
+	// for this long. A Save or Delete operation of an application discards its cached results. See ConfigCacheStats.
+	ConfigCacheTTL time.Duration
+
+	// The interceptors which every operation goes through, the first being the outermost. More can be added with
+	// AddInterceptors.
+	Interceptors []Interceptor
+
+	// How long the rollback of a failed credential rotation may take, as it runs even when the context of the rotation
+	// is done. When zero, DefaultCredentialRollbackTimeout is used.
+	CredentialRollbackTimeout time.Duration
+
+	// When true, NewPushServiceV1 wraps the logger of the go-sdk-core library with NewRedactingLogger, so that the
+	// requests and responses it dumps at debug level do not expose credentials. The go-sdk-core logger is global to the
+	// process, so this also redacts the logs of every other client of the library.
+	RedactLogs bool
 }
 
 // NewPushServiceV1UsingExternalConfig : constructs an instance of PushServiceV1 with passed in options and external configuration.
@@ -77,7 +148,7 @@ func NewPushServiceV1UsingExternalConfig(options *PushServiceV1Options) (pushSer
 	}
 
 	if options.Authenticator == nil {
-		options.Authenticator, err = core.GetAuthenticatorFromEnvironment(options.ServiceName)
+		options.Authenticator, err = getAuthenticatorFromEnvironment(options.ServiceName)
 		if err != nil {
 			return
 		}
@@ -93,8 +164,9 @@ func NewPushServiceV1UsingExternalConfig(options *PushServiceV1Options) (pushSer
 		return
 	}
 
-	if options.URL != "" {
-		err = pushService.Service.SetServiceURL(options.URL)
+	url, err := options.serviceURL()
+	if err == nil && url != "" {
+		err = pushService.Service.SetServiceURL(url)
 	}
 	return
 }
@@ -106,28 +178,83 @@ func NewPushServiceV1(options *PushServiceV1Options) (service *PushServiceV1, er
 		Authenticator: options.Authenticator,
 	}
 
+	url, err := options.serviceURL()
+	if err != nil {
+		return
+	}
+
 	baseService, err := core.NewBaseService(serviceOptions)
 	if err != nil {
 		return
 	}
 
-	if options.URL != "" {
-		err = baseService.SetServiceURL(options.URL)
+	if url != "" {
+		err = baseService.SetServiceURL(url)
 		if err != nil {
 			return
 		}
 	}
 
+	err = options.configureHTTPClient(baseService)
+	if err != nil {
+		return
+	}
+
 	service = &PushServiceV1{
-		Service: baseService,
+		Service:               baseService,
+		lock:                  new(sync.RWMutex),
+		httpClient:            baseService.Client,
+		validateSafariURLArgs: options.ValidateSafariURLArgs,
+		safariURLFormats:      newSafariURLFormatCache(),
+		configCache:           newConfigCache(options.ConfigCacheTTL),
+		interceptors:          append([]Interceptor(nil), options.Interceptors...),
+		rollbackTimeout:       options.CredentialRollbackTimeout,
+	}
+
+	if options.RedactLogs {
+		installRedactingLogger()
 	}
 
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
+// serviceURL returns the URL set by the options, either directly or through their region, or "" if they set neither.
+func (options *PushServiceV1Options) serviceURL() (string, error) {
+	if options.Region == "" {
+		return options.URL, nil
+	}
+	if options.URL != "" {
+		return "", fmt.Errorf("only one of URL and Region may be set")
+	}
+	return GetServiceURLForRegion(options.Region)
+}
+
+// regionalServiceURLs maps the regions of the push service to their public and private service URLs.
+var regionalServiceURLs = map[string]string{
+	"us-south":         "https://us-south.imfpush.cloud.ibm.com/imfpush/v1",
+	"eu-gb":            "https://eu-gb.imfpush.cloud.ibm.com/imfpush/v1",
+	"eu-de":            "https://eu-de.imfpush.cloud.ibm.com/imfpush/v1",
+	"au-syd":           "https://au-syd.imfpush.cloud.ibm.com/imfpush/v1",
+	"jp-tok":           "https://jp-tok.imfpush.cloud.ibm.com/imfpush/v1",
+	"private.us-south": "https://private.us-south.imfpush.cloud.ibm.com/imfpush/v1",
+	"private.eu-gb":    "https://private.eu-gb.imfpush.cloud.ibm.com/imfpush/v1",
+	"private.eu-de":    "https://private.eu-de.imfpush.cloud.ibm.com/imfpush/v1",
+	"private.au-syd":   "https://private.au-syd.imfpush.cloud.ibm.com/imfpush/v1",
+	"private.jp-tok":   "https://private.jp-tok.imfpush.cloud.ibm.com/imfpush/v1",
+}
+
+// GetServiceURLForRegion returns the service URL to be used for the specified region. The private endpoint of a
+// region is selected with the "private." prefix, such as private.us-south.
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", fmt.Errorf("service does not support regional URLs")
+	if url, ok := regionalServiceURLs[region]; ok {
+		return url, nil
+	}
+	regions := make([]string, 0, len(regionalServiceURLs))
+	for name := range regionalServiceURLs {
+		regions = append(regions, name)
+	}
+	sort.Strings(regions)
+	return "", fmt.Errorf("unknown region '%s', the supported regions are: %s", region, strings.Join(regions, ", "))
 }
 
 // Clone makes a copy of "pushService" suitable for processing requests.
@@ -135,45 +262,75 @@ func (pushService *PushServiceV1) Clone() *PushServiceV1 {
 	if core.IsNil(pushService) {
 		return nil
 	}
-	clone := *pushService
-	clone.Service = pushService.Service.Clone()
-	return &clone
+	clone := pushService.snapshot()
+	clone.Service = clone.Service.Clone()
+	clone.lock = new(sync.RWMutex)
+	return clone
 }
 
 // SetServiceURL sets the service URL
 func (pushService *PushServiceV1) SetServiceURL(url string) error {
-	return pushService.Service.SetServiceURL(url)
+	return pushService.reconfigure(func(service *core.BaseService) error {
+		return service.SetServiceURL(url)
+	})
 }
 
 // GetServiceURL returns the service URL
 func (pushService *PushServiceV1) GetServiceURL() string {
-	return pushService.Service.GetServiceURL()
+	return pushService.baseService().GetServiceURL()
 }
 
 // SetDefaultHeaders sets HTTP headers to be sent in every request
 func (pushService *PushServiceV1) SetDefaultHeaders(headers http.Header) {
-	pushService.Service.SetDefaultHeaders(headers)
+	_ = pushService.reconfigure(func(service *core.BaseService) error {
+		service.SetDefaultHeaders(headers.Clone())
+		return nil
+	})
 }
 
 // SetEnableGzipCompression sets the service's EnableGzipCompression field
 func (pushService *PushServiceV1) SetEnableGzipCompression(enableGzip bool) {
-	pushService.Service.SetEnableGzipCompression(enableGzip)
+	_ = pushService.reconfigure(func(service *core.BaseService) error {
+		service.SetEnableGzipCompression(enableGzip)
+		return nil
+	})
 }
 
 // GetEnableGzipCompression returns the service's EnableGzipCompression field
 func (pushService *PushServiceV1) GetEnableGzipCompression() bool {
-	return pushService.Service.GetEnableGzipCompression()
+	return pushService.baseService().GetEnableGzipCompression()
 }
 
 // EnableRetries enables automatic retries for requests invoked for this service instance.
 // If either parameter is specified as 0, then a default value is used instead.
+// The retries are made with the HTTP client configured by the transport options of PushServiceV1Options.
 func (pushService *PushServiceV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
-	pushService.Service.EnableRetries(maxRetries, maxRetryInterval)
+	_ = pushService.reconfigure(func(service *core.BaseService) error {
+		// As core.BaseService.EnableRetries, but retrying with the configured client rather than a default one.
+		client := core.NewRetryableHTTPClient()
+		if maxRetries > 0 {
+			client.RetryMax = maxRetries
+		}
+		if maxRetryInterval > 0 {
+			client.RetryWaitMax = maxRetryInterval
+		}
+		if pushService.httpClient != nil {
+			client.HTTPClient = pushService.httpClient
+		}
+		service.SetHTTPClient(client.StandardClient())
+		return nil
+	})
 }
 
 // DisableRetries disables automatic retries for requests invoked for this service instance.
 func (pushService *PushServiceV1) DisableRetries() {
-	pushService.Service.DisableRetries()
+	_ = pushService.reconfigure(func(service *core.BaseService) error {
+		service.DisableRetries()
+		if pushService.httpClient != nil {
+			service.SetHTTPClient(pushService.httpClient)
+		}
+		return nil
+	})
 }
 
 // GetSettings : Retrieve application settings
@@ -197,10 +354,11 @@ func (pushService *PushServiceV1) GetSettingsWithContext(ctx context.Context, ge
 		"applicationId": *getSettingsOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -227,7 +385,7 @@ func (pushService *PushServiceV1) GetSettingsWithContext(ctx context.Context, ge
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -261,10 +419,11 @@ func (pushService *PushServiceV1) GetApnsConfWithContext(ctx context.Context, ge
 		"applicationId": *getApnsConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -291,7 +450,7 @@ func (pushService *PushServiceV1) GetApnsConfWithContext(ctx context.Context, ge
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetApnsConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -321,14 +480,23 @@ func (pushService *PushServiceV1) SaveApnsConfWithContext(ctx context.Context, s
 		return
 	}
 
+	certificate := saveApnsConfOptions.Certificate
+	if saveApnsConfOptions.Force == nil || !*saveApnsConfOptions.Force {
+		certificate, err = inspectApnsCertificateUpload(saveApnsConfOptions)
+		if err != nil {
+			return
+		}
+	}
+
 	pathParamsMap := map[string]string{
 		"applicationId": *saveApnsConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -349,18 +517,30 @@ func (pushService *PushServiceV1) SaveApnsConfWithContext(ctx context.Context, s
 		builder.AddHeader("appSecret", fmt.Sprint(*saveApnsConfOptions.AppSecret))
 	}
 
-	builder.AddFormData("password", "", "", fmt.Sprint(*saveApnsConfOptions.Password))
-	builder.AddFormData("isSandBox", "", "", fmt.Sprint(*saveApnsConfOptions.IsSandBox))
-	builder.AddFormData("certificate", fmt.Sprintf("%s.p12", stringWithCharset(24)),
-		core.StringNilMapper(saveApnsConfOptions.CertificateContentType), saveApnsConfOptions.Certificate)
+	body, err := newMultipartBody(
+		&multipartPart{fieldName: "password", value: fmt.Sprint(*saveApnsConfOptions.Password)},
+		&multipartPart{fieldName: "isSandBox", value: fmt.Sprint(*saveApnsConfOptions.IsSandBox)},
+		newMultipartFilePart("certificate", "certificate.p12", certificate,
+			saveApnsConfOptions.CertificateContentType, "application/x-pkcs12"),
+	)
+	if err != nil {
+		return
+	}
+	defer body.Close()
+	builder.AddHeader("Content-Type", body.contentType)
+	_, err = builder.SetBodyContentStream(body.requestBody())
+	if err != nil {
+		return
+	}
 
 	request, err := builder.Build()
 	if err != nil {
 		return
 	}
+	body.prepareRequest(request)
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveApnsConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -394,10 +574,11 @@ func (pushService *PushServiceV1) DeleteApnsConfWithContext(ctx context.Context,
 		"applicationId": *deleteApnsConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/apnsConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -422,7 +603,7 @@ func (pushService *PushServiceV1) DeleteApnsConfWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteApnsConf", request, nil)
 
 	return
 }
@@ -448,10 +629,11 @@ func (pushService *PushServiceV1) GetGCMConfWithContext(ctx context.Context, get
 		"applicationId": *getGCMConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -478,7 +660,7 @@ func (pushService *PushServiceV1) GetGCMConfWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetGCMConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -512,10 +694,11 @@ func (pushService *PushServiceV1) SaveGCMConfWithContext(ctx context.Context, sa
 		"applicationId": *saveGCMConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -555,7 +738,7 @@ func (pushService *PushServiceV1) SaveGCMConfWithContext(ctx context.Context, sa
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveGCMConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -589,10 +772,11 @@ func (pushService *PushServiceV1) DeleteGCMConfWithContext(ctx context.Context,
 		"applicationId": *deleteGCMConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/gcmConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -617,7 +801,7 @@ func (pushService *PushServiceV1) DeleteGCMConfWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteGCMConf", request, nil)
 
 	return
 }
@@ -643,10 +827,11 @@ func (pushService *PushServiceV1) GetWebpushServerKeyWithContext(ctx context.Con
 		"applicationId": *getWebpushServerKeyOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/webpushServerKey`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/webpushServerKey`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -673,7 +858,7 @@ func (pushService *PushServiceV1) GetWebpushServerKeyWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetWebpushServerKey", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -707,10 +892,11 @@ func (pushService *PushServiceV1) GetSafariWebConfWithContext(ctx context.Contex
 		"applicationId": *getSafariWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -737,7 +923,7 @@ func (pushService *PushServiceV1) GetSafariWebConfWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetSafariWebConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -774,10 +960,11 @@ func (pushService *PushServiceV1) SaveSafariWebConfWithContext(ctx context.Conte
 		"applicationId": *saveSafariWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -798,45 +985,47 @@ func (pushService *PushServiceV1) SaveSafariWebConfWithContext(ctx context.Conte
 		builder.AddHeader("appSecret", fmt.Sprint(*saveSafariWebConfOptions.AppSecret))
 	}
 
-	builder.AddFormData("password", "", "", fmt.Sprint(*saveSafariWebConfOptions.Password))
-	builder.AddFormData("certificate", fmt.Sprintf("%s.p12", stringWithCharset(24)),
-		core.StringNilMapper(saveSafariWebConfOptions.CertificateContentType), saveSafariWebConfOptions.Certificate)
-	builder.AddFormData("websiteName", "", "", fmt.Sprint(*saveSafariWebConfOptions.WebsiteName))
-	builder.AddFormData("urlFormatString", "", "", fmt.Sprint(*saveSafariWebConfOptions.UrlFormatString))
-	builder.AddFormData("websitePushID", "", "", fmt.Sprint(*saveSafariWebConfOptions.WebsitePushID))
-	builder.AddFormData("webSiteUrl", "", "", fmt.Sprint(*saveSafariWebConfOptions.WebSiteURL))
-	if saveSafariWebConfOptions.Icon16x16 != nil {
-		builder.AddFormData("icon_16x16", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon16x16ContentType), saveSafariWebConfOptions.Icon16x16)
-	}
-	if saveSafariWebConfOptions.Icon16x162x != nil {
-		builder.AddFormData("icon_16x16@2x", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon16x162xContentType), saveSafariWebConfOptions.Icon16x162x)
-	}
-	if saveSafariWebConfOptions.Icon32x32 != nil {
-		builder.AddFormData("icon_32x32", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon32x32ContentType), saveSafariWebConfOptions.Icon32x32)
-	}
-	if saveSafariWebConfOptions.Icon32x322x != nil {
-		builder.AddFormData("icon_32x32@2x", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon32x322xContentType), saveSafariWebConfOptions.Icon32x322x)
-	}
-	if saveSafariWebConfOptions.Icon128x128 != nil {
-		builder.AddFormData("icon_128x128", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon128x128ContentType), saveSafariWebConfOptions.Icon128x128)
+	body, err := newMultipartBody(
+		&multipartPart{fieldName: "password", value: fmt.Sprint(*saveSafariWebConfOptions.Password)},
+		newMultipartFilePart("certificate", "certificate.p12", saveSafariWebConfOptions.Certificate,
+			saveSafariWebConfOptions.CertificateContentType, "application/x-pkcs12"),
+		&multipartPart{fieldName: "websiteName", value: fmt.Sprint(*saveSafariWebConfOptions.WebsiteName)},
+		&multipartPart{fieldName: "urlFormatString", value: fmt.Sprint(*saveSafariWebConfOptions.UrlFormatString)},
+		&multipartPart{fieldName: "websitePushID", value: fmt.Sprint(*saveSafariWebConfOptions.WebsitePushID)},
+		&multipartPart{fieldName: "webSiteUrl", value: fmt.Sprint(*saveSafariWebConfOptions.WebSiteURL)},
+		newMultipartFilePart("icon_16x16", "icon_16x16.png", saveSafariWebConfOptions.Icon16x16,
+			saveSafariWebConfOptions.Icon16x16ContentType, "image/png"),
+		newMultipartFilePart("icon_16x16@2x", "icon_16x16@2x.png", saveSafariWebConfOptions.Icon16x162x,
+			saveSafariWebConfOptions.Icon16x162xContentType, "image/png"),
+		newMultipartFilePart("icon_32x32", "icon_32x32.png", saveSafariWebConfOptions.Icon32x32,
+			saveSafariWebConfOptions.Icon32x32ContentType, "image/png"),
+		newMultipartFilePart("icon_32x32@2x", "icon_32x32@2x.png", saveSafariWebConfOptions.Icon32x322x,
+			saveSafariWebConfOptions.Icon32x322xContentType, "image/png"),
+		newMultipartFilePart("icon_128x128", "icon_128x128.png", saveSafariWebConfOptions.Icon128x128,
+			saveSafariWebConfOptions.Icon128x128ContentType, "image/png"),
+		newMultipartFilePart("icon_128x128@2x", "icon_128x128@2x.png", saveSafariWebConfOptions.Icon128x1282x,
+			saveSafariWebConfOptions.Icon128x1282xContentType, "image/png"),
+	)
+	if err != nil {
+		return
 	}
-	if saveSafariWebConfOptions.Icon128x1282x != nil {
-		builder.AddFormData("icon_128x128@2x", fmt.Sprintf("%s.p12", stringWithCharset(12)),
-			core.StringNilMapper(saveSafariWebConfOptions.Icon128x1282xContentType), saveSafariWebConfOptions.Icon128x1282x)
+	defer body.Close()
+	builder.AddHeader("Content-Type", body.contentType)
+	_, err = builder.SetBodyContentStream(body.requestBody())
+	if err != nil {
+		return
 	}
 
 	request, err := builder.Build()
 	if err != nil {
 		return
 	}
+	body.prepareRequest(request)
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveSafariWebConf", request, &rawResponse)
+	// Even a failed save may have changed the configuration.
+	pushService.safariURLFormats.invalidate(*saveSafariWebConfOptions.ApplicationID)
 	if err != nil {
 		return
 	}
@@ -871,10 +1060,11 @@ func (pushService *PushServiceV1) DeleteSafariWebConfWithContext(ctx context.Con
 		"applicationId": *deleteSafariWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/safariWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -899,7 +1089,9 @@ func (pushService *PushServiceV1) DeleteSafariWebConfWithContext(ctx context.Con
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteSafariWebConf", request, nil)
+	// Even a failed delete may have changed the configuration.
+	pushService.safariURLFormats.invalidate(*deleteSafariWebConfOptions.ApplicationID)
 
 	return
 }
@@ -925,10 +1117,11 @@ func (pushService *PushServiceV1) GetGcmConfPublicWithContext(ctx context.Contex
 		"applicationId": *getGcmConfPublicOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/gcmConfPublic`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/gcmConfPublic`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -955,7 +1148,7 @@ func (pushService *PushServiceV1) GetGcmConfPublicWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetGcmConfPublic", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -989,10 +1182,11 @@ func (pushService *PushServiceV1) GetChromeWebConfWithContext(ctx context.Contex
 		"applicationId": *getChromeWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1019,7 +1213,7 @@ func (pushService *PushServiceV1) GetChromeWebConfWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetChromeWebConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1053,10 +1247,11 @@ func (pushService *PushServiceV1) SaveChromeWebConfWithContext(ctx context.Conte
 		"applicationId": *saveChromeWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1096,7 +1291,7 @@ func (pushService *PushServiceV1) SaveChromeWebConfWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveChromeWebConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1130,10 +1325,11 @@ func (pushService *PushServiceV1) DeleteChromeWebConfWithContext(ctx context.Con
 		"applicationId": *deleteChromeWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1158,7 +1354,7 @@ func (pushService *PushServiceV1) DeleteChromeWebConfWithContext(ctx context.Con
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteChromeWebConf", request, nil)
 
 	return
 }
@@ -1184,10 +1380,11 @@ func (pushService *PushServiceV1) GetFirefoxWebConfWithContext(ctx context.Conte
 		"applicationId": *getFirefoxWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1214,7 +1411,7 @@ func (pushService *PushServiceV1) GetFirefoxWebConfWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetFirefoxWebConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1248,10 +1445,11 @@ func (pushService *PushServiceV1) SaveFirefoxWebConfWithContext(ctx context.Cont
 		"applicationId": *saveFirefoxWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1288,7 +1486,7 @@ func (pushService *PushServiceV1) SaveFirefoxWebConfWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveFirefoxWebConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1322,10 +1520,11 @@ func (pushService *PushServiceV1) DeleteFirefoxWebConfWithContext(ctx context.Co
 		"applicationId": *deleteFirefoxWebConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/firefoxWebConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1350,7 +1549,7 @@ func (pushService *PushServiceV1) DeleteFirefoxWebConfWithContext(ctx context.Co
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteFirefoxWebConf", request, nil)
 
 	return
 }
@@ -1376,10 +1575,11 @@ func (pushService *PushServiceV1) GetChromeAppExtConfWithContext(ctx context.Con
 		"applicationId": *getChromeAppExtConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1406,7 +1606,7 @@ func (pushService *PushServiceV1) GetChromeAppExtConfWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetChromeAppExtConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1440,10 +1640,11 @@ func (pushService *PushServiceV1) SaveChromeAppExtConfWithContext(ctx context.Co
 		"applicationId": *saveChromeAppExtConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1483,7 +1684,7 @@ func (pushService *PushServiceV1) SaveChromeAppExtConfWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SaveChromeAppExtConf", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1518,10 +1719,11 @@ func (pushService *PushServiceV1) DeleteChromeAppExtConfWithContext(ctx context.
 		"applicationId": *deleteChromeAppExtConfOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.DELETE)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeAppExtConf`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1546,7 +1748,7 @@ func (pushService *PushServiceV1) DeleteChromeAppExtConfWithContext(ctx context.
 		return
 	}
 
-	response, err = pushService.Service.Request(request, nil)
+	response, err = pushService.request(service, "DeleteChromeAppExtConf", request, nil)
 
 	return
 }
@@ -1572,10 +1774,11 @@ func (pushService *PushServiceV1) GetChromeAppExtConfPublicWithContext(ctx conte
 		"applicationId": *getChromeAppExtConfPublicOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/settings/chromeAppExtConfPublic`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/settings/chromeAppExtConfPublic`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1602,7 +1805,7 @@ func (pushService *PushServiceV1) GetChromeAppExtConfPublicWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "GetChromeAppExtConfPublic", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1633,15 +1836,26 @@ func (pushService *PushServiceV1) SendMessageWithContext(ctx context.Context, se
 	if err != nil {
 		return
 	}
+	err = sendMessageOptions.Settings.Validate()
+	if err != nil {
+		return
+	}
+	if pushService.validateSafariURLArgs && sendMessageOptions.Settings != nil && sendMessageOptions.Settings.SafariWeb != nil {
+		err = pushService.ValidateSafariURLArgsWithContext(ctx, *sendMessageOptions.ApplicationID, sendMessageOptions.Settings.SafariWeb.UrlArgs)
+		if err != nil {
+			return
+		}
+	}
 
 	pathParamsMap := map[string]string{
 		"applicationId": *sendMessageOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/messages`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/messages`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1659,6 +1873,9 @@ func (pushService *PushServiceV1) SendMessageWithContext(ctx context.Context, se
 	if sendMessageOptions.AcceptLanguage != nil {
 		builder.AddHeader("Accept-Language", fmt.Sprint(*sendMessageOptions.AcceptLanguage))
 	}
+	if sendMessageOptions.AppSecret != nil {
+		builder.AddHeader("appSecret", fmt.Sprint(*sendMessageOptions.AppSecret))
+	}
 
 	body := make(map[string]interface{})
 	if sendMessageOptions.Message != nil {
@@ -1684,7 +1901,7 @@ func (pushService *PushServiceV1) SendMessageWithContext(ctx context.Context, se
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SendMessage", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1715,15 +1932,28 @@ func (pushService *PushServiceV1) SendMessagesInBulkWithContext(ctx context.Cont
 	if err != nil {
 		return
 	}
+	for _, messageBody := range sendMessagesInBulkOptions.Body {
+		err = messageBody.Settings.Validate()
+		if err != nil {
+			return
+		}
+		if pushService.validateSafariURLArgs && messageBody.Settings != nil && messageBody.Settings.SafariWeb != nil {
+			err = pushService.ValidateSafariURLArgsWithContext(ctx, *sendMessagesInBulkOptions.ApplicationID, messageBody.Settings.SafariWeb.UrlArgs)
+			if err != nil {
+				return
+			}
+		}
+	}
 
 	pathParamsMap := map[string]string{
 		"applicationId": *sendMessagesInBulkOptions.ApplicationID,
 	}
 
+	service := pushService.baseService()
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
-	builder.EnableGzipCompression = pushService.GetEnableGzipCompression()
-	_, err = builder.ResolveRequestURL(pushService.Service.Options.URL, `/apps/{applicationId}/messages/bulk`, pathParamsMap)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), `/apps/{applicationId}/messages/bulk`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1756,7 +1986,7 @@ func (pushService *PushServiceV1) SendMessagesInBulkWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = pushService.Service.Request(request, &rawResponse)
+	response, err = pushService.request(service, "SendMessagesInBulk", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1831,6 +2061,38 @@ type Apns struct {
 
 	// The number of items the notification adds to the category’s summary format string.
 	ApnsGroupSummaryArgCount *int64 `json:"apnsGroupSummaryArgCount,omitempty"`
+
+	// The importance and delivery timing of the notification (Supported only on iOS 15 and above).
+	InterruptionLevel *string `json:"interruptionLevel,omitempty"`
+
+	// The relevance score, a number between 0 and 1, that the system uses to sort the notifications from your app
+	// (Supported only on iOS 15 and above).
+	RelevanceScore *float64 `json:"relevanceScore,omitempty"`
+
+	// The identifier of the window brought forward when the notification is opened.
+	TargetContentID *string `json:"targetContentId,omitempty"`
+
+	// When set to true, the notification service app extension can modify the content of the notification before it is
+	// displayed.
+	MutableContent *bool `json:"mutableContent,omitempty"`
+
+	// The sound to play for a critical alert. Use either this or sound, not both.
+	CriticalSound *ApnsCriticalSound `json:"criticalSound,omitempty"`
+
+	// The type of the notification, sent as the apns-push-type header. Required for watchOS 6 and above, recommended for
+	// all other platforms.
+	ApnsPushType *string `json:"apnsPushType,omitempty"`
+
+	// The date, in seconds since the UNIX epoch, at which the notification is no longer valid. A value of 0 means APNs
+	// attempts to deliver the notification only once and does not store it.
+	ApnsExpiration *int64 `json:"apnsExpiration,omitempty"`
+
+	// The priority of the notification: 10 to send it immediately, 5 to send it based on power considerations on the
+	// device and 1 to prioritize the device's power considerations over all other factors.
+	ApnsPriority *int64 `json:"apnsPriority,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Apns.Type property.
@@ -1840,6 +2102,143 @@ const (
 	Apns_Type_Silent  = "SILENT"
 )
 
+// Constants associated with the Apns.InterruptionLevel property.
+// The importance and delivery timing of the notification (Supported only on iOS 15 and above).
+const (
+	Apns_InterruptionLevel_Active        = "active"
+	Apns_InterruptionLevel_Critical      = "critical"
+	Apns_InterruptionLevel_Passive       = "passive"
+	Apns_InterruptionLevel_TimeSensitive = "time-sensitive"
+)
+
+// Constants associated with the Apns.ApnsPushType property.
+// The type of the notification, sent as the apns-push-type header.
+const (
+	Apns_ApnsPushType_Alert        = "alert"
+	Apns_ApnsPushType_Background   = "background"
+	Apns_ApnsPushType_Liveactivity = "liveactivity"
+	Apns_ApnsPushType_Voip         = "voip"
+)
+
+// SetProperty allows the user to set an arbitrary property on an instance of Apns
+func (o *Apns) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Apns
+func (o *Apns) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Apns
+func (o *Apns) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Apns
+func (o *Apns) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Apns
+func (o *Apns) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Badge != nil {
+		m["badge"] = o.Badge
+	}
+	if o.InteractiveCategory != nil {
+		m["interactiveCategory"] = o.InteractiveCategory
+	}
+	if o.Category != nil {
+		m["category"] = o.Category
+	}
+	if o.IosActionKey != nil {
+		m["iosActionKey"] = o.IosActionKey
+	}
+	if o.Payload != nil {
+		m["payload"] = o.Payload
+	}
+	if o.Sound != nil {
+		m["sound"] = o.Sound
+	}
+	if o.TitleLocKey != nil {
+		m["titleLocKey"] = o.TitleLocKey
+	}
+	if o.LocKey != nil {
+		m["locKey"] = o.LocKey
+	}
+	if o.LaunchImage != nil {
+		m["launchImage"] = o.LaunchImage
+	}
+	if o.TitleLocArgs != nil {
+		m["titleLocArgs"] = o.TitleLocArgs
+	}
+	if o.LocArgs != nil {
+		m["locArgs"] = o.LocArgs
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.Subtitle != nil {
+		m["subtitle"] = o.Subtitle
+	}
+	if o.AttachmentURL != nil {
+		m["attachmentUrl"] = o.AttachmentURL
+	}
+	if o.Type != nil {
+		m["type"] = o.Type
+	}
+	if o.ApnsCollapseID != nil {
+		m["apnsCollapseId"] = o.ApnsCollapseID
+	}
+	if o.ApnsThreadID != nil {
+		m["apnsThreadId"] = o.ApnsThreadID
+	}
+	if o.ApnsGroupSummaryArg != nil {
+		m["apnsGroupSummaryArg"] = o.ApnsGroupSummaryArg
+	}
+	if o.ApnsGroupSummaryArgCount != nil {
+		m["apnsGroupSummaryArgCount"] = o.ApnsGroupSummaryArgCount
+	}
+	if o.InterruptionLevel != nil {
+		m["interruptionLevel"] = o.InterruptionLevel
+	}
+	if o.RelevanceScore != nil {
+		m["relevanceScore"] = o.RelevanceScore
+	}
+	if o.TargetContentID != nil {
+		m["targetContentId"] = o.TargetContentID
+	}
+	if o.MutableContent != nil {
+		m["mutableContent"] = o.MutableContent
+	}
+	if o.CriticalSound != nil {
+		m["criticalSound"] = o.CriticalSound
+	}
+	if o.ApnsPushType != nil {
+		m["apnsPushType"] = o.ApnsPushType
+	}
+	if o.ApnsExpiration != nil {
+		m["apnsExpiration"] = o.ApnsExpiration
+	}
+	if o.ApnsPriority != nil {
+		m["apnsPriority"] = o.ApnsPriority
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalApns unmarshals an instance of Apns from the specified map of raw messages.
 func UnmarshalApns(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Apns)
@@ -1847,78 +2246,244 @@ func UnmarshalApns(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	delete(m, "badge")
 	err = core.UnmarshalPrimitive(m, "interactiveCategory", &obj.InteractiveCategory)
 	if err != nil {
 		return
 	}
+	delete(m, "interactiveCategory")
 	err = core.UnmarshalPrimitive(m, "category", &obj.Category)
 	if err != nil {
 		return
 	}
+	delete(m, "category")
 	err = core.UnmarshalPrimitive(m, "iosActionKey", &obj.IosActionKey)
 	if err != nil {
 		return
 	}
+	delete(m, "iosActionKey")
 	err = core.UnmarshalPrimitive(m, "payload", &obj.Payload)
 	if err != nil {
 		return
 	}
+	delete(m, "payload")
 	err = core.UnmarshalPrimitive(m, "sound", &obj.Sound)
 	if err != nil {
 		return
 	}
+	delete(m, "sound")
 	err = core.UnmarshalPrimitive(m, "titleLocKey", &obj.TitleLocKey)
 	if err != nil {
 		return
 	}
+	delete(m, "titleLocKey")
 	err = core.UnmarshalPrimitive(m, "locKey", &obj.LocKey)
 	if err != nil {
 		return
 	}
+	delete(m, "locKey")
 	err = core.UnmarshalPrimitive(m, "launchImage", &obj.LaunchImage)
 	if err != nil {
 		return
 	}
+	delete(m, "launchImage")
 	err = core.UnmarshalPrimitive(m, "titleLocArgs", &obj.TitleLocArgs)
 	if err != nil {
 		return
 	}
+	delete(m, "titleLocArgs")
 	err = core.UnmarshalPrimitive(m, "locArgs", &obj.LocArgs)
 	if err != nil {
 		return
 	}
+	delete(m, "locArgs")
 	err = core.UnmarshalPrimitive(m, "title", &obj.Title)
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "subtitle", &obj.Subtitle)
 	if err != nil {
 		return
 	}
+	delete(m, "subtitle")
 	err = core.UnmarshalPrimitive(m, "attachmentUrl", &obj.AttachmentURL)
 	if err != nil {
 		return
 	}
+	delete(m, "attachmentUrl")
 	err = core.UnmarshalPrimitive(m, "type", &obj.Type)
 	if err != nil {
 		return
 	}
+	delete(m, "type")
 	err = core.UnmarshalPrimitive(m, "apnsCollapseId", &obj.ApnsCollapseID)
 	if err != nil {
 		return
 	}
+	delete(m, "apnsCollapseId")
 	err = core.UnmarshalPrimitive(m, "apnsThreadId", &obj.ApnsThreadID)
 	if err != nil {
 		return
 	}
+	delete(m, "apnsThreadId")
 	err = core.UnmarshalPrimitive(m, "apnsGroupSummaryArg", &obj.ApnsGroupSummaryArg)
 	if err != nil {
 		return
 	}
+	delete(m, "apnsGroupSummaryArg")
 	err = core.UnmarshalPrimitive(m, "apnsGroupSummaryArgCount", &obj.ApnsGroupSummaryArgCount)
 	if err != nil {
 		return
 	}
+	delete(m, "apnsGroupSummaryArgCount")
+	err = core.UnmarshalPrimitive(m, "interruptionLevel", &obj.InterruptionLevel)
+	if err != nil {
+		return
+	}
+	delete(m, "interruptionLevel")
+	err = core.UnmarshalPrimitive(m, "relevanceScore", &obj.RelevanceScore)
+	if err != nil {
+		return
+	}
+	delete(m, "relevanceScore")
+	err = core.UnmarshalPrimitive(m, "targetContentId", &obj.TargetContentID)
+	if err != nil {
+		return
+	}
+	delete(m, "targetContentId")
+	err = core.UnmarshalPrimitive(m, "mutableContent", &obj.MutableContent)
+	if err != nil {
+		return
+	}
+	delete(m, "mutableContent")
+	err = core.UnmarshalModel(m, "criticalSound", &obj.CriticalSound, UnmarshalApnsCriticalSound)
+	if err != nil {
+		return
+	}
+	delete(m, "criticalSound")
+	err = core.UnmarshalPrimitive(m, "apnsPushType", &obj.ApnsPushType)
+	if err != nil {
+		return
+	}
+	delete(m, "apnsPushType")
+	err = core.UnmarshalPrimitive(m, "apnsExpiration", &obj.ApnsExpiration)
+	if err != nil {
+		return
+	}
+	delete(m, "apnsExpiration")
+	err = core.UnmarshalPrimitive(m, "apnsPriority", &obj.ApnsPriority)
+	if err != nil {
+		return
+	}
+	delete(m, "apnsPriority")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ApnsCriticalSound : The sound to play for a critical alert on iOS 12 and above.
+type ApnsCriticalSound struct {
+	// The critical alert flag. Set to 1 to enable the critical alert.
+	Critical *int64 `json:"critical,omitempty"`
+
+	// The name of the sound file in the application bundle or in the Library/Sounds folder of the app's data container.
+	Name *string `json:"name" validate:"required"`
+
+	// The volume for the critical alert's sound, between 0 (silent) and 1 (full volume).
+	Volume *float64 `json:"volume,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// NewApnsCriticalSound : Instantiate ApnsCriticalSound (Generic Model Constructor)
+func (*PushServiceV1) NewApnsCriticalSound(name string) (model *ApnsCriticalSound, err error) {
+	model = &ApnsCriticalSound{
+		Name: core.StringPtr(name),
+	}
+	err = core.ValidateStruct(model, "required parameters")
+	return
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of ApnsCriticalSound
+func (o *ApnsCriticalSound) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ApnsCriticalSound
+func (o *ApnsCriticalSound) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ApnsCriticalSound
+func (o *ApnsCriticalSound) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ApnsCriticalSound
+func (o *ApnsCriticalSound) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ApnsCriticalSound
+func (o *ApnsCriticalSound) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Critical != nil {
+		m["critical"] = o.Critical
+	}
+	m["name"] = o.Name
+	if o.Volume != nil {
+		m["volume"] = o.Volume
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
+// UnmarshalApnsCriticalSound unmarshals an instance of ApnsCriticalSound from the specified map of raw messages.
+func UnmarshalApnsCriticalSound(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ApnsCriticalSound)
+	err = core.UnmarshalPrimitive(m, "critical", &obj.Critical)
+	if err != nil {
+		return
+	}
+	delete(m, "critical")
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		return
+	}
+	delete(m, "name")
+	err = core.UnmarshalPrimitive(m, "volume", &obj.Volume)
+	if err != nil {
+		return
+	}
+	delete(m, "volume")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1927,6 +2492,48 @@ func UnmarshalApns(m map[string]json.RawMessage, result interface{}) (err error)
 type ApplicationServerKeyModel struct {
 	// Application Server key for Web Push Identification.
 	WebpushServerKey *string `json:"webpushServerKey" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of ApplicationServerKeyModel
+func (o *ApplicationServerKeyModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ApplicationServerKeyModel
+func (o *ApplicationServerKeyModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ApplicationServerKeyModel
+func (o *ApplicationServerKeyModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ApplicationServerKeyModel
+func (o *ApplicationServerKeyModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ApplicationServerKeyModel
+func (o *ApplicationServerKeyModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["webpushServerKey"] = o.WebpushServerKey
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalApplicationServerKeyModel unmarshals an instance of ApplicationServerKeyModel from the specified map of raw messages.
@@ -1936,6 +2543,15 @@ func UnmarshalApplicationServerKeyModel(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	delete(m, "webpushServerKey")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1960,6 +2576,65 @@ type ChromeAppExt struct {
 
 	// Custom JSON payload that will be sent as part of the notification message.
 	Payload *string `json:"payload,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of ChromeAppExt
+func (o *ChromeAppExt) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ChromeAppExt
+func (o *ChromeAppExt) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ChromeAppExt
+func (o *ChromeAppExt) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ChromeAppExt
+func (o *ChromeAppExt) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ChromeAppExt
+func (o *ChromeAppExt) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.CollapseKey != nil {
+		m["collapseKey"] = o.CollapseKey
+	}
+	if o.DelayWhileIdle != nil {
+		m["delayWhileIdle"] = o.DelayWhileIdle
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.IconURL != nil {
+		m["iconUrl"] = o.IconURL
+	}
+	if o.TimeToLive != nil {
+		m["timeToLive"] = o.TimeToLive
+	}
+	if o.Payload != nil {
+		m["payload"] = o.Payload
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalChromeAppExt unmarshals an instance of ChromeAppExt from the specified map of raw messages.
@@ -1969,26 +2644,40 @@ func UnmarshalChromeAppExt(m map[string]json.RawMessage, result interface{}) (er
 	if err != nil {
 		return
 	}
+	delete(m, "collapseKey")
 	err = core.UnmarshalPrimitive(m, "delayWhileIdle", &obj.DelayWhileIdle)
 	if err != nil {
 		return
 	}
+	delete(m, "delayWhileIdle")
 	err = core.UnmarshalPrimitive(m, "title", &obj.Title)
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "iconUrl", &obj.IconURL)
 	if err != nil {
 		return
 	}
+	delete(m, "iconUrl")
 	err = core.UnmarshalPrimitive(m, "timeToLive", &obj.TimeToLive)
 	if err != nil {
 		return
 	}
+	delete(m, "timeToLive")
 	err = core.UnmarshalPrimitive(m, "payload", &obj.Payload)
 	if err != nil {
 		return
 	}
+	delete(m, "payload")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2007,27 +2696,223 @@ type ChromeWeb struct {
 	// Custom JSON payload that will be sent as part of the
 	//   notification message.
 	Payload *string `json:"payload,omitempty"`
-}
 
-// UnmarshalChromeWeb unmarshals an instance of ChromeWeb from the specified map of raw messages.
-func UnmarshalChromeWeb(m map[string]json.RawMessage, result interface{}) (err error) {
+	// The action buttons to display with the WebPush Notification. Browsers display at most MaxWebPushActions actions.
+	Actions []WebPushAction `json:"actions,omitempty"`
+
+	// The URL of the monochrome image used to represent the WebPush Notification when there is not enough space to display
+	// the notification itself.
+	BadgeURL *string `json:"badgeUrl,omitempty"`
+
+	// The URL of an image to be displayed as part of the WebPush Notification.
+	ImageURL *string `json:"imageUrl,omitempty"`
+
+	// The body text of the WebPush Notification. When not set, the alert of the message is used.
+	Body *string `json:"body,omitempty"`
+
+	// When set to true, the WebPush Notification remains active until the user clicks or dismisses it.
+	RequireInteraction *bool `json:"requireInteraction,omitempty"`
+
+	// When set to true, the WebPush Notification is shown without any sound or vibration.
+	Silent *bool `json:"silent,omitempty"`
+
+	// The vibration pattern, in milliseconds, for devices with vibration hardware.
+	Vibrate []int64 `json:"vibrate,omitempty"`
+
+	// Identifier used to replace an existing WebPush Notification with the same tag.
+	Tag *string `json:"tag,omitempty"`
+
+	// When set to true, the user is notified again when a notification replaces an older one with the same tag.
+	Renotify *bool `json:"renotify,omitempty"`
+
+	// The urgency of the message, sent as the Web Push Urgency header.
+	Urgency *string `json:"urgency,omitempty"`
+
+	// A topic used to replace a pending message that has not yet been delivered, sent as the Web Push Topic header.
+	Topic *string `json:"topic,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// Constants associated with the ChromeWeb.Urgency property.
+// The urgency of the message, sent as the Web Push Urgency header.
+const (
+	ChromeWeb_Urgency_High    = "high"
+	ChromeWeb_Urgency_Low     = "low"
+	ChromeWeb_Urgency_Normal  = "normal"
+	ChromeWeb_Urgency_VeryLow = "very-low"
+)
+
+// SetProperty allows the user to set an arbitrary property on an instance of ChromeWeb
+func (o *ChromeWeb) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ChromeWeb
+func (o *ChromeWeb) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ChromeWeb
+func (o *ChromeWeb) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ChromeWeb
+func (o *ChromeWeb) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ChromeWeb
+func (o *ChromeWeb) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.IconURL != nil {
+		m["iconUrl"] = o.IconURL
+	}
+	if o.TimeToLive != nil {
+		m["timeToLive"] = o.TimeToLive
+	}
+	if o.Payload != nil {
+		m["payload"] = o.Payload
+	}
+	if o.Actions != nil {
+		m["actions"] = o.Actions
+	}
+	if o.BadgeURL != nil {
+		m["badgeUrl"] = o.BadgeURL
+	}
+	if o.ImageURL != nil {
+		m["imageUrl"] = o.ImageURL
+	}
+	if o.Body != nil {
+		m["body"] = o.Body
+	}
+	if o.RequireInteraction != nil {
+		m["requireInteraction"] = o.RequireInteraction
+	}
+	if o.Silent != nil {
+		m["silent"] = o.Silent
+	}
+	if o.Vibrate != nil {
+		m["vibrate"] = o.Vibrate
+	}
+	if o.Tag != nil {
+		m["tag"] = o.Tag
+	}
+	if o.Renotify != nil {
+		m["renotify"] = o.Renotify
+	}
+	if o.Urgency != nil {
+		m["urgency"] = o.Urgency
+	}
+	if o.Topic != nil {
+		m["topic"] = o.Topic
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
+// UnmarshalChromeWeb unmarshals an instance of ChromeWeb from the specified map of raw messages.
+func UnmarshalChromeWeb(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ChromeWeb)
 	err = core.UnmarshalPrimitive(m, "title", &obj.Title)
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "iconUrl", &obj.IconURL)
 	if err != nil {
 		return
 	}
+	delete(m, "iconUrl")
 	err = core.UnmarshalPrimitive(m, "timeToLive", &obj.TimeToLive)
 	if err != nil {
 		return
 	}
+	delete(m, "timeToLive")
 	err = core.UnmarshalPrimitive(m, "payload", &obj.Payload)
 	if err != nil {
 		return
 	}
+	delete(m, "payload")
+	err = core.UnmarshalModel(m, "actions", &obj.Actions, UnmarshalWebPushAction)
+	if err != nil {
+		return
+	}
+	delete(m, "actions")
+	err = core.UnmarshalPrimitive(m, "badgeUrl", &obj.BadgeURL)
+	if err != nil {
+		return
+	}
+	delete(m, "badgeUrl")
+	err = core.UnmarshalPrimitive(m, "imageUrl", &obj.ImageURL)
+	if err != nil {
+		return
+	}
+	delete(m, "imageUrl")
+	err = core.UnmarshalPrimitive(m, "body", &obj.Body)
+	if err != nil {
+		return
+	}
+	delete(m, "body")
+	err = core.UnmarshalPrimitive(m, "requireInteraction", &obj.RequireInteraction)
+	if err != nil {
+		return
+	}
+	delete(m, "requireInteraction")
+	err = core.UnmarshalPrimitive(m, "silent", &obj.Silent)
+	if err != nil {
+		return
+	}
+	delete(m, "silent")
+	err = core.UnmarshalPrimitive(m, "vibrate", &obj.Vibrate)
+	if err != nil {
+		return
+	}
+	delete(m, "vibrate")
+	err = core.UnmarshalPrimitive(m, "tag", &obj.Tag)
+	if err != nil {
+		return
+	}
+	delete(m, "tag")
+	err = core.UnmarshalPrimitive(m, "renotify", &obj.Renotify)
+	if err != nil {
+		return
+	}
+	delete(m, "renotify")
+	err = core.UnmarshalPrimitive(m, "urgency", &obj.Urgency)
+	if err != nil {
+		return
+	}
+	delete(m, "urgency")
+	err = core.UnmarshalPrimitive(m, "topic", &obj.Topic)
+	if err != nil {
+		return
+	}
+	delete(m, "topic")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2039,6 +2924,9 @@ type ChromeWebPushCredendialsModel struct {
 
 	// The URL of the WebSite / WebApp that should be permitted to subscribe to WebPush.
 	WebSiteURL *string `json:"webSiteUrl" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewChromeWebPushCredendialsModel : Instantiate ChromeWebPushCredendialsModel (Generic Model Constructor)
@@ -2051,6 +2939,46 @@ func (*PushServiceV1) NewChromeWebPushCredendialsModel(apiKey string, webSiteURL
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of ChromeWebPushCredendialsModel
+func (o *ChromeWebPushCredendialsModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ChromeWebPushCredendialsModel
+func (o *ChromeWebPushCredendialsModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ChromeWebPushCredendialsModel
+func (o *ChromeWebPushCredendialsModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ChromeWebPushCredendialsModel
+func (o *ChromeWebPushCredendialsModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ChromeWebPushCredendialsModel
+func (o *ChromeWebPushCredendialsModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["apiKey"] = o.ApiKey
+	m["webSiteUrl"] = o.WebSiteURL
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalChromeWebPushCredendialsModel unmarshals an instance of ChromeWebPushCredendialsModel from the specified map of raw messages.
 func UnmarshalChromeWebPushCredendialsModel(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ChromeWebPushCredendialsModel)
@@ -2058,10 +2986,20 @@ func UnmarshalChromeWebPushCredendialsModel(m map[string]json.RawMessage, result
 	if err != nil {
 		return
 	}
+	delete(m, "apiKey")
 	err = core.UnmarshalPrimitive(m, "webSiteUrl", &obj.WebSiteURL)
 	if err != nil {
 		return
 	}
+	delete(m, "webSiteUrl")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2355,6 +3293,135 @@ type FirefoxWeb struct {
 
 	// Custom JSON payload that will be sent as part of the notification message.
 	Payload *string `json:"payload,omitempty"`
+
+	// The action buttons to display with the WebPush Notification. Browsers display at most MaxWebPushActions actions.
+	Actions []WebPushAction `json:"actions,omitempty"`
+
+	// The URL of the monochrome image used to represent the WebPush Notification when there is not enough space to display
+	// the notification itself.
+	BadgeURL *string `json:"badgeUrl,omitempty"`
+
+	// The URL of an image to be displayed as part of the WebPush Notification.
+	ImageURL *string `json:"imageUrl,omitempty"`
+
+	// The body text of the WebPush Notification. When not set, the alert of the message is used.
+	Body *string `json:"body,omitempty"`
+
+	// When set to true, the WebPush Notification remains active until the user clicks or dismisses it.
+	RequireInteraction *bool `json:"requireInteraction,omitempty"`
+
+	// When set to true, the WebPush Notification is shown without any sound or vibration.
+	Silent *bool `json:"silent,omitempty"`
+
+	// The vibration pattern, in milliseconds, for devices with vibration hardware.
+	Vibrate []int64 `json:"vibrate,omitempty"`
+
+	// Identifier used to replace an existing WebPush Notification with the same tag.
+	Tag *string `json:"tag,omitempty"`
+
+	// When set to true, the user is notified again when a notification replaces an older one with the same tag.
+	Renotify *bool `json:"renotify,omitempty"`
+
+	// The urgency of the message, sent as the Web Push Urgency header.
+	Urgency *string `json:"urgency,omitempty"`
+
+	// A topic used to replace a pending message that has not yet been delivered, sent as the Web Push Topic header.
+	Topic *string `json:"topic,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// Constants associated with the FirefoxWeb.Urgency property.
+// The urgency of the message, sent as the Web Push Urgency header.
+const (
+	FirefoxWeb_Urgency_High    = "high"
+	FirefoxWeb_Urgency_Low     = "low"
+	FirefoxWeb_Urgency_Normal  = "normal"
+	FirefoxWeb_Urgency_VeryLow = "very-low"
+)
+
+// SetProperty allows the user to set an arbitrary property on an instance of FirefoxWeb
+func (o *FirefoxWeb) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of FirefoxWeb
+func (o *FirefoxWeb) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of FirefoxWeb
+func (o *FirefoxWeb) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of FirefoxWeb
+func (o *FirefoxWeb) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of FirefoxWeb
+func (o *FirefoxWeb) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.IconURL != nil {
+		m["iconUrl"] = o.IconURL
+	}
+	if o.TimeToLive != nil {
+		m["timeToLive"] = o.TimeToLive
+	}
+	if o.Payload != nil {
+		m["payload"] = o.Payload
+	}
+	if o.Actions != nil {
+		m["actions"] = o.Actions
+	}
+	if o.BadgeURL != nil {
+		m["badgeUrl"] = o.BadgeURL
+	}
+	if o.ImageURL != nil {
+		m["imageUrl"] = o.ImageURL
+	}
+	if o.Body != nil {
+		m["body"] = o.Body
+	}
+	if o.RequireInteraction != nil {
+		m["requireInteraction"] = o.RequireInteraction
+	}
+	if o.Silent != nil {
+		m["silent"] = o.Silent
+	}
+	if o.Vibrate != nil {
+		m["vibrate"] = o.Vibrate
+	}
+	if o.Tag != nil {
+		m["tag"] = o.Tag
+	}
+	if o.Renotify != nil {
+		m["renotify"] = o.Renotify
+	}
+	if o.Urgency != nil {
+		m["urgency"] = o.Urgency
+	}
+	if o.Topic != nil {
+		m["topic"] = o.Topic
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalFirefoxWeb unmarshals an instance of FirefoxWeb from the specified map of raw messages.
@@ -2364,18 +3431,85 @@ func UnmarshalFirefoxWeb(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "iconUrl", &obj.IconURL)
 	if err != nil {
 		return
 	}
+	delete(m, "iconUrl")
 	err = core.UnmarshalPrimitive(m, "timeToLive", &obj.TimeToLive)
 	if err != nil {
 		return
 	}
+	delete(m, "timeToLive")
 	err = core.UnmarshalPrimitive(m, "payload", &obj.Payload)
 	if err != nil {
 		return
 	}
+	delete(m, "payload")
+	err = core.UnmarshalModel(m, "actions", &obj.Actions, UnmarshalWebPushAction)
+	if err != nil {
+		return
+	}
+	delete(m, "actions")
+	err = core.UnmarshalPrimitive(m, "badgeUrl", &obj.BadgeURL)
+	if err != nil {
+		return
+	}
+	delete(m, "badgeUrl")
+	err = core.UnmarshalPrimitive(m, "imageUrl", &obj.ImageURL)
+	if err != nil {
+		return
+	}
+	delete(m, "imageUrl")
+	err = core.UnmarshalPrimitive(m, "body", &obj.Body)
+	if err != nil {
+		return
+	}
+	delete(m, "body")
+	err = core.UnmarshalPrimitive(m, "requireInteraction", &obj.RequireInteraction)
+	if err != nil {
+		return
+	}
+	delete(m, "requireInteraction")
+	err = core.UnmarshalPrimitive(m, "silent", &obj.Silent)
+	if err != nil {
+		return
+	}
+	delete(m, "silent")
+	err = core.UnmarshalPrimitive(m, "vibrate", &obj.Vibrate)
+	if err != nil {
+		return
+	}
+	delete(m, "vibrate")
+	err = core.UnmarshalPrimitive(m, "tag", &obj.Tag)
+	if err != nil {
+		return
+	}
+	delete(m, "tag")
+	err = core.UnmarshalPrimitive(m, "renotify", &obj.Renotify)
+	if err != nil {
+		return
+	}
+	delete(m, "renotify")
+	err = core.UnmarshalPrimitive(m, "urgency", &obj.Urgency)
+	if err != nil {
+		return
+	}
+	delete(m, "urgency")
+	err = core.UnmarshalPrimitive(m, "topic", &obj.Topic)
+	if err != nil {
+		return
+	}
+	delete(m, "topic")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2384,6 +3518,9 @@ func UnmarshalFirefoxWeb(m map[string]json.RawMessage, result interface{}) (err
 type FirefoxWebPushCredendialsModel struct {
 	// The URL of the WebSite / WebApp that should be permitted to subscribe to WebPush.
 	WebSiteURL *string `json:"webSiteUrl" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewFirefoxWebPushCredendialsModel : Instantiate FirefoxWebPushCredendialsModel (Generic Model Constructor)
@@ -2395,6 +3532,45 @@ func (*PushServiceV1) NewFirefoxWebPushCredendialsModel(webSiteURL string) (mode
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of FirefoxWebPushCredendialsModel
+func (o *FirefoxWebPushCredendialsModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of FirefoxWebPushCredendialsModel
+func (o *FirefoxWebPushCredendialsModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of FirefoxWebPushCredendialsModel
+func (o *FirefoxWebPushCredendialsModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of FirefoxWebPushCredendialsModel
+func (o *FirefoxWebPushCredendialsModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of FirefoxWebPushCredendialsModel
+func (o *FirefoxWebPushCredendialsModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["webSiteUrl"] = o.WebSiteURL
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalFirefoxWebPushCredendialsModel unmarshals an instance of FirefoxWebPushCredendialsModel from the specified map of raw messages.
 func UnmarshalFirefoxWebPushCredendialsModel(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(FirefoxWebPushCredendialsModel)
@@ -2402,6 +3578,15 @@ func UnmarshalFirefoxWebPushCredendialsModel(m map[string]json.RawMessage, resul
 	if err != nil {
 		return
 	}
+	delete(m, "webSiteUrl")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2413,6 +3598,9 @@ type GCMCredendialsModel struct {
 
 	// Project Number in the Google Developers Console.
 	SenderID *string `json:"senderId" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewGCMCredendialsModel : Instantiate GCMCredendialsModel (Generic Model Constructor)
@@ -2425,6 +3613,46 @@ func (*PushServiceV1) NewGCMCredendialsModel(apiKey string, senderID string) (mo
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of GCMCredendialsModel
+func (o *GCMCredendialsModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of GCMCredendialsModel
+func (o *GCMCredendialsModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of GCMCredendialsModel
+func (o *GCMCredendialsModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of GCMCredendialsModel
+func (o *GCMCredendialsModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GCMCredendialsModel
+func (o *GCMCredendialsModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["apiKey"] = o.ApiKey
+	m["senderId"] = o.SenderID
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalGCMCredendialsModel unmarshals an instance of GCMCredendialsModel from the specified map of raw messages.
 func UnmarshalGCMCredendialsModel(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(GCMCredendialsModel)
@@ -2432,10 +3660,20 @@ func UnmarshalGCMCredendialsModel(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	delete(m, "apiKey")
 	err = core.UnmarshalPrimitive(m, "senderId", &obj.SenderID)
 	if err != nil {
 		return
 	}
+	delete(m, "senderId")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2444,6 +3682,48 @@ func UnmarshalGCMCredendialsModel(m map[string]json.RawMessage, result interface
 type GCMCredendialsPublicModel struct {
 	// Project Number in the Google Developers Console.
 	SenderID *string `json:"senderId" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of GCMCredendialsPublicModel
+func (o *GCMCredendialsPublicModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of GCMCredendialsPublicModel
+func (o *GCMCredendialsPublicModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of GCMCredendialsPublicModel
+func (o *GCMCredendialsPublicModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of GCMCredendialsPublicModel
+func (o *GCMCredendialsPublicModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GCMCredendialsPublicModel
+func (o *GCMCredendialsPublicModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["senderId"] = o.SenderID
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalGCMCredendialsPublicModel unmarshals an instance of GCMCredendialsPublicModel from the specified map of raw messages.
@@ -2453,6 +3733,15 @@ func UnmarshalGCMCredendialsPublicModel(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	delete(m, "senderId")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2476,8 +3765,8 @@ type Gcm struct {
 	// Device group messaging makes it possible for every app instance in a group to reflect the latest messaging state.
 	Sync *bool `json:"sync,omitempty"`
 
-	// private/public - Visibility of this notification, which affects how and when the notifications are revealed on a
-	// secure locked screen.
+	// private/public/secret - Visibility of this notification, which affects how and when the notifications are revealed
+	// on a secure locked screen.
 	Visibility *string `json:"visibility,omitempty"`
 
 	// Content specified will show up on a secure locked screen on the device when visibility is set to Private.
@@ -2516,14 +3805,182 @@ type Gcm struct {
 	Style *Style `json:"style,omitempty"`
 
 	Type *string `json:"type,omitempty"`
+
+	// The notification's icon color, expressed in #rrggbb format.
+	Color *string `json:"color,omitempty"`
+
+	// The URL of an image to be downloaded on the device and displayed in the notification.
+	ImageURL *string `json:"imageUrl,omitempty"`
+
+	// The action associated with a user click on the notification. An activity with a matching intent filter is launched
+	// when the user clicks on the notification.
+	ClickAction *string `json:"clickAction,omitempty"`
+
+	// Identifier used to replace existing notifications in the notification drawer. If not specified, each request creates
+	// a new notification.
+	Tag *string `json:"tag,omitempty"`
+
+	// The ticker text sent to accessibility services.
+	Ticker *string `json:"ticker,omitempty"`
+
+	// The number of items this notification represents, displayed as the badge count on launchers that support it.
+	NotificationCount *int64 `json:"notificationCount,omitempty"`
+
+	// When set to false or unset, the notification is automatically dismissed when the user clicks it in the panel. When
+	// set to true, the notification persists even when the user clicks it.
+	Sticky *bool `json:"sticky,omitempty"`
+
+	// Set whether or not this notification is relevant only to the current device.
+	LocalOnly *bool `json:"localOnly,omitempty"`
+
+	// The vibration pattern to use, in milliseconds. The first value indicates the duration to wait before turning the
+	// vibrator on, the next value the duration to keep the vibrator on, and subsequent values alternate between the two.
+	VibrationPattern []int64 `json:"vibrationPattern,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
+// Constants associated with the Gcm.Visibility property.
+// Visibility of this notification, which affects how and when the notifications are revealed on a secure locked screen.
+const (
+	Gcm_Visibility_Private = "private"
+	Gcm_Visibility_Public  = "public"
+	Gcm_Visibility_Secret  = "secret"
+)
+
+// Constants associated with the Gcm.Priority property.
+// A string value that indicates the priority of this notification.
+const (
+	Gcm_Priority_Default = "default"
+	Gcm_Priority_High    = "high"
+	Gcm_Priority_Low     = "low"
+	Gcm_Priority_Max     = "max"
+	Gcm_Priority_Min     = "min"
+)
+
 // Constants associated with the Gcm.Type property.
 const (
 	Gcm_Type_Default = "DEFAULT"
 	Gcm_Type_Silent  = "SILENT"
 )
 
+// SetProperty allows the user to set an arbitrary property on an instance of Gcm
+func (o *Gcm) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Gcm
+func (o *Gcm) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Gcm
+func (o *Gcm) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Gcm
+func (o *Gcm) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Gcm
+func (o *Gcm) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.CollapseKey != nil {
+		m["collapseKey"] = o.CollapseKey
+	}
+	if o.InteractiveCategory != nil {
+		m["interactiveCategory"] = o.InteractiveCategory
+	}
+	if o.Icon != nil {
+		m["icon"] = o.Icon
+	}
+	if o.DelayWhileIdle != nil {
+		m["delayWhileIdle"] = o.DelayWhileIdle
+	}
+	if o.Sync != nil {
+		m["sync"] = o.Sync
+	}
+	if o.Visibility != nil {
+		m["visibility"] = o.Visibility
+	}
+	if o.Redact != nil {
+		m["redact"] = o.Redact
+	}
+	if o.ChannelID != nil {
+		m["channelId"] = o.ChannelID
+	}
+	if o.Payload != nil {
+		m["payload"] = o.Payload
+	}
+	if o.Priority != nil {
+		m["priority"] = o.Priority
+	}
+	if o.Sound != nil {
+		m["sound"] = o.Sound
+	}
+	if o.TimeToLive != nil {
+		m["timeToLive"] = o.TimeToLive
+	}
+	if o.Lights != nil {
+		m["lights"] = o.Lights
+	}
+	if o.AndroidTitle != nil {
+		m["androidTitle"] = o.AndroidTitle
+	}
+	if o.GroupID != nil {
+		m["groupId"] = o.GroupID
+	}
+	if o.Style != nil {
+		m["style"] = o.Style
+	}
+	if o.Type != nil {
+		m["type"] = o.Type
+	}
+	if o.Color != nil {
+		m["color"] = o.Color
+	}
+	if o.ImageURL != nil {
+		m["imageUrl"] = o.ImageURL
+	}
+	if o.ClickAction != nil {
+		m["clickAction"] = o.ClickAction
+	}
+	if o.Tag != nil {
+		m["tag"] = o.Tag
+	}
+	if o.Ticker != nil {
+		m["ticker"] = o.Ticker
+	}
+	if o.NotificationCount != nil {
+		m["notificationCount"] = o.NotificationCount
+	}
+	if o.Sticky != nil {
+		m["sticky"] = o.Sticky
+	}
+	if o.LocalOnly != nil {
+		m["localOnly"] = o.LocalOnly
+	}
+	if o.VibrationPattern != nil {
+		m["vibrationPattern"] = o.VibrationPattern
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalGcm unmarshals an instance of Gcm from the specified map of raw messages.
 func UnmarshalGcm(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Gcm)
@@ -2531,70 +3988,140 @@ func UnmarshalGcm(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	delete(m, "collapseKey")
 	err = core.UnmarshalPrimitive(m, "interactiveCategory", &obj.InteractiveCategory)
 	if err != nil {
 		return
 	}
+	delete(m, "interactiveCategory")
 	err = core.UnmarshalPrimitive(m, "icon", &obj.Icon)
 	if err != nil {
 		return
 	}
+	delete(m, "icon")
 	err = core.UnmarshalPrimitive(m, "delayWhileIdle", &obj.DelayWhileIdle)
 	if err != nil {
 		return
 	}
+	delete(m, "delayWhileIdle")
 	err = core.UnmarshalPrimitive(m, "sync", &obj.Sync)
 	if err != nil {
 		return
 	}
+	delete(m, "sync")
 	err = core.UnmarshalPrimitive(m, "visibility", &obj.Visibility)
 	if err != nil {
 		return
 	}
+	delete(m, "visibility")
 	err = core.UnmarshalPrimitive(m, "redact", &obj.Redact)
 	if err != nil {
 		return
 	}
+	delete(m, "redact")
 	err = core.UnmarshalPrimitive(m, "channelId", &obj.ChannelID)
 	if err != nil {
 		return
 	}
+	delete(m, "channelId")
 	err = core.UnmarshalPrimitive(m, "payload", &obj.Payload)
 	if err != nil {
 		return
 	}
+	delete(m, "payload")
 	err = core.UnmarshalPrimitive(m, "priority", &obj.Priority)
 	if err != nil {
 		return
 	}
+	delete(m, "priority")
 	err = core.UnmarshalPrimitive(m, "sound", &obj.Sound)
 	if err != nil {
 		return
 	}
+	delete(m, "sound")
 	err = core.UnmarshalPrimitive(m, "timeToLive", &obj.TimeToLive)
 	if err != nil {
 		return
 	}
+	delete(m, "timeToLive")
 	err = core.UnmarshalModel(m, "lights", &obj.Lights, UnmarshalLights)
 	if err != nil {
 		return
 	}
+	delete(m, "lights")
 	err = core.UnmarshalPrimitive(m, "androidTitle", &obj.AndroidTitle)
 	if err != nil {
 		return
 	}
+	delete(m, "androidTitle")
 	err = core.UnmarshalPrimitive(m, "groupId", &obj.GroupID)
 	if err != nil {
 		return
 	}
+	delete(m, "groupId")
 	err = core.UnmarshalModel(m, "style", &obj.Style, UnmarshalStyle)
 	if err != nil {
 		return
 	}
+	delete(m, "style")
 	err = core.UnmarshalPrimitive(m, "type", &obj.Type)
 	if err != nil {
 		return
 	}
+	delete(m, "type")
+	err = core.UnmarshalPrimitive(m, "color", &obj.Color)
+	if err != nil {
+		return
+	}
+	delete(m, "color")
+	err = core.UnmarshalPrimitive(m, "imageUrl", &obj.ImageURL)
+	if err != nil {
+		return
+	}
+	delete(m, "imageUrl")
+	err = core.UnmarshalPrimitive(m, "clickAction", &obj.ClickAction)
+	if err != nil {
+		return
+	}
+	delete(m, "clickAction")
+	err = core.UnmarshalPrimitive(m, "tag", &obj.Tag)
+	if err != nil {
+		return
+	}
+	delete(m, "tag")
+	err = core.UnmarshalPrimitive(m, "ticker", &obj.Ticker)
+	if err != nil {
+		return
+	}
+	delete(m, "ticker")
+	err = core.UnmarshalPrimitive(m, "notificationCount", &obj.NotificationCount)
+	if err != nil {
+		return
+	}
+	delete(m, "notificationCount")
+	err = core.UnmarshalPrimitive(m, "sticky", &obj.Sticky)
+	if err != nil {
+		return
+	}
+	delete(m, "sticky")
+	err = core.UnmarshalPrimitive(m, "localOnly", &obj.LocalOnly)
+	if err != nil {
+		return
+	}
+	delete(m, "localOnly")
+	err = core.UnmarshalPrimitive(m, "vibrationPattern", &obj.VibrationPattern)
+	if err != nil {
+		return
+	}
+	delete(m, "vibrationPattern")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3070,6 +4597,56 @@ type Lights struct {
 
 	// The number of milliseconds for the LED to be off while it's flashing. The hardware will do its best approximation.
 	LedOffMs *string `json:"ledOffMs,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Lights
+func (o *Lights) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Lights
+func (o *Lights) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Lights
+func (o *Lights) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Lights
+func (o *Lights) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Lights
+func (o *Lights) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.LedArgb != nil {
+		m["ledArgb"] = o.LedArgb
+	}
+	if o.LedOnMs != nil {
+		m["ledOnMs"] = o.LedOnMs
+	}
+	if o.LedOffMs != nil {
+		m["ledOffMs"] = o.LedOffMs
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalLights unmarshals an instance of Lights from the specified map of raw messages.
@@ -3079,14 +4656,25 @@ func UnmarshalLights(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	delete(m, "ledArgb")
 	err = core.UnmarshalPrimitive(m, "ledOnMs", &obj.LedOnMs)
 	if err != nil {
 		return
 	}
+	delete(m, "ledOnMs")
 	err = core.UnmarshalPrimitive(m, "ledOffMs", &obj.LedOffMs)
 	if err != nil {
 		return
 	}
+	delete(m, "ledOffMs")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3098,6 +4686,53 @@ type Message struct {
 
 	// An optional URL that can be sent along with the alert.
 	URL *string `json:"url,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Message
+func (o *Message) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Message
+func (o *Message) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Message
+func (o *Message) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Message
+func (o *Message) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Message
+func (o *Message) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Alert != nil {
+		m["alert"] = o.Alert
+	}
+	if o.URL != nil {
+		m["url"] = o.URL
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalMessage unmarshals an instance of Message from the specified map of raw messages.
@@ -3107,10 +4742,20 @@ func UnmarshalMessage(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	delete(m, "alert")
 	err = core.UnmarshalPrimitive(m, "url", &obj.URL)
 	if err != nil {
 		return
 	}
+	delete(m, "url")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3121,6 +4766,53 @@ type MessageResponseModel struct {
 
 	// Unique Id for the message.
 	MessageID *string `json:"messageId,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of MessageResponseModel
+func (o *MessageResponseModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of MessageResponseModel
+func (o *MessageResponseModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of MessageResponseModel
+func (o *MessageResponseModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of MessageResponseModel
+func (o *MessageResponseModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of MessageResponseModel
+func (o *MessageResponseModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Message != nil {
+		m["message"] = o.Message
+	}
+	if o.MessageID != nil {
+		m["messageId"] = o.MessageID
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalMessageResponseModel unmarshals an instance of MessageResponseModel from the specified map of raw messages.
@@ -3130,10 +4822,20 @@ func UnmarshalMessageResponseModel(m map[string]json.RawMessage, result interfac
 	if err != nil {
 		return
 	}
+	delete(m, "message")
 	err = core.UnmarshalPrimitive(m, "messageId", &obj.MessageID)
 	if err != nil {
 		return
 	}
+	delete(m, "messageId")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3142,6 +4844,50 @@ func UnmarshalMessageResponseModel(m map[string]json.RawMessage, result interfac
 type MessagesArrayModel struct {
 	// An array of messages.
 	Messages []MessagesList `json:"messages,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of MessagesArrayModel
+func (o *MessagesArrayModel) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of MessagesArrayModel
+func (o *MessagesArrayModel) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of MessagesArrayModel
+func (o *MessagesArrayModel) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of MessagesArrayModel
+func (o *MessagesArrayModel) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of MessagesArrayModel
+func (o *MessagesArrayModel) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Messages != nil {
+		m["messages"] = o.Messages
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalMessagesArrayModel unmarshals an instance of MessagesArrayModel from the specified map of raw messages.
@@ -3151,6 +4897,15 @@ func UnmarshalMessagesArrayModel(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	delete(m, "messages")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3168,6 +4923,59 @@ type MessagesList struct {
 
 	// The URL to the message resource.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of MessagesList
+func (o *MessagesList) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of MessagesList
+func (o *MessagesList) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of MessagesList
+func (o *MessagesList) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of MessagesList
+func (o *MessagesList) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of MessagesList
+func (o *MessagesList) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.CreatedTime != nil {
+		m["createdTime"] = o.CreatedTime
+	}
+	if o.MessageID != nil {
+		m["messageId"] = o.MessageID
+	}
+	if o.Alert != nil {
+		m["alert"] = o.Alert
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalMessagesList unmarshals an instance of MessagesList from the specified map of raw messages.
@@ -3177,18 +4985,30 @@ func UnmarshalMessagesList(m map[string]json.RawMessage, result interface{}) (er
 	if err != nil {
 		return
 	}
+	delete(m, "createdTime")
 	err = core.UnmarshalPrimitive(m, "messageId", &obj.MessageID)
 	if err != nil {
 		return
 	}
+	delete(m, "messageId")
 	err = core.UnmarshalPrimitive(m, "alert", &obj.Alert)
 	if err != nil {
 		return
 	}
+	delete(m, "alert")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		return
 	}
+	delete(m, "href")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3203,6 +5023,56 @@ type SafariWeb struct {
 
 	// The label of the action button.
 	Action *string `json:"action,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of SafariWeb
+func (o *SafariWeb) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SafariWeb
+func (o *SafariWeb) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SafariWeb
+func (o *SafariWeb) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SafariWeb
+func (o *SafariWeb) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SafariWeb
+func (o *SafariWeb) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.UrlArgs != nil {
+		m["urlArgs"] = o.UrlArgs
+	}
+	if o.Action != nil {
+		m["action"] = o.Action
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalSafariWeb unmarshals an instance of SafariWeb from the specified map of raw messages.
@@ -3212,14 +5082,25 @@ func UnmarshalSafariWeb(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "urlArgs", &obj.UrlArgs)
 	if err != nil {
 		return
 	}
+	delete(m, "urlArgs")
 	err = core.UnmarshalPrimitive(m, "action", &obj.Action)
 	if err != nil {
 		return
 	}
+	delete(m, "action")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3247,6 +5128,10 @@ type SaveApnsConfOptions struct {
 	// Deprecated, use Authorization instead.
 	AppSecret *string
 
+	// Upload the certificate without inspecting it locally. By default, the .p12 certificate is decoded with Password
+	// and refused if it cannot be read, is not an Apple Push certificate, is expired or does not match IsSandBox.
+	Force *bool
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -3303,6 +5188,12 @@ func (options *SaveApnsConfOptions) SetAppSecret(appSecret string) *SaveApnsConf
 	return options
 }
 
+// SetForce : Allow user to set Force
+func (options *SaveApnsConfOptions) SetForce(force bool) *SaveApnsConfOptions {
+	options.Force = core.BoolPtr(force)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *SaveApnsConfOptions) SetHeaders(param map[string]string) *SaveApnsConfOptions {
 	options.Headers = param
@@ -3564,6 +5455,9 @@ func (options *SaveGCMConfOptions) SetHeaders(param map[string]string) *SaveGCMC
 }
 
 // SaveSafariWebConfOptions : The SaveSafariWebConf options.
+//
+// The certificate and icons are streamed to the service. When they are all seekable, such as *os.File, the request is
+// sent with a Content-Length and can be retried; otherwise it is sent chunked.
 type SaveSafariWebConfOptions struct {
 	// Unique ID of the application using the push service.
 	ApplicationID *string `validate:"required,ne="`
@@ -3809,6 +5703,10 @@ type SendMessageOptions struct {
 	// The preferred language to use for error messages.
 	AcceptLanguage *string
 
+	// The app secret of the application, sent as the appSecret header. It overrides, for this call only, the app secret
+	// of an AppSecretAuthenticator.
+	AppSecret *string
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -3857,6 +5755,12 @@ func (options *SendMessageOptions) SetAcceptLanguage(acceptLanguage string) *Sen
 	return options
 }
 
+// SetAppSecret : Allow user to set AppSecret
+func (options *SendMessageOptions) SetAppSecret(appSecret string) *SendMessageOptions {
+	options.AppSecret = core.StringPtr(appSecret)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *SendMessageOptions) SetHeaders(param map[string]string) *SendMessageOptions {
 	options.Headers = param
@@ -3937,6 +5841,65 @@ type Settings struct {
 
 	// Web Push Notifications settings specific to Chrome  browser.
 	ChromeAppExt *ChromeAppExt `json:"chromeAppExt,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Settings
+func (o *Settings) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Settings
+func (o *Settings) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Settings
+func (o *Settings) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Settings
+func (o *Settings) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Settings
+func (o *Settings) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Apns != nil {
+		m["apns"] = o.Apns
+	}
+	if o.Gcm != nil {
+		m["gcm"] = o.Gcm
+	}
+	if o.FirefoxWeb != nil {
+		m["firefoxWeb"] = o.FirefoxWeb
+	}
+	if o.ChromeWeb != nil {
+		m["chromeWeb"] = o.ChromeWeb
+	}
+	if o.SafariWeb != nil {
+		m["safariWeb"] = o.SafariWeb
+	}
+	if o.ChromeAppExt != nil {
+		m["chromeAppExt"] = o.ChromeAppExt
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalSettings unmarshals an instance of Settings from the specified map of raw messages.
@@ -3946,26 +5909,40 @@ func UnmarshalSettings(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	delete(m, "apns")
 	err = core.UnmarshalModel(m, "gcm", &obj.Gcm, UnmarshalGcm)
 	if err != nil {
 		return
 	}
+	delete(m, "gcm")
 	err = core.UnmarshalModel(m, "firefoxWeb", &obj.FirefoxWeb, UnmarshalFirefoxWeb)
 	if err != nil {
 		return
 	}
+	delete(m, "firefoxWeb")
 	err = core.UnmarshalModel(m, "chromeWeb", &obj.ChromeWeb, UnmarshalChromeWeb)
 	if err != nil {
 		return
 	}
+	delete(m, "chromeWeb")
 	err = core.UnmarshalModel(m, "safariWeb", &obj.SafariWeb, UnmarshalSafariWeb)
 	if err != nil {
 		return
 	}
+	delete(m, "safariWeb")
 	err = core.UnmarshalModel(m, "chromeAppExt", &obj.ChromeAppExt, UnmarshalChromeAppExt)
 	if err != nil {
 		return
 	}
+	delete(m, "chromeAppExt")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3991,6 +5968,62 @@ type Style struct {
 	// An array of strings that is to be displayed in inbox style for inbox_notification.  Must be specified for
 	// inbox_notification.
 	Lines []string `json:"lines,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Style
+func (o *Style) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Style
+func (o *Style) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Style
+func (o *Style) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Style
+func (o *Style) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Style
+func (o *Style) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Type != nil {
+		m["type"] = o.Type
+	}
+	if o.Title != nil {
+		m["title"] = o.Title
+	}
+	if o.URL != nil {
+		m["url"] = o.URL
+	}
+	if o.Text != nil {
+		m["text"] = o.Text
+	}
+	if o.Lines != nil {
+		m["lines"] = o.Lines
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalStyle unmarshals an instance of Style from the specified map of raw messages.
@@ -4000,22 +6033,35 @@ func UnmarshalStyle(m map[string]json.RawMessage, result interface{}) (err error
 	if err != nil {
 		return
 	}
+	delete(m, "type")
 	err = core.UnmarshalPrimitive(m, "title", &obj.Title)
 	if err != nil {
 		return
 	}
+	delete(m, "title")
 	err = core.UnmarshalPrimitive(m, "url", &obj.URL)
 	if err != nil {
 		return
 	}
+	delete(m, "url")
 	err = core.UnmarshalPrimitive(m, "text", &obj.Text)
 	if err != nil {
 		return
 	}
+	delete(m, "text")
 	err = core.UnmarshalPrimitive(m, "lines", &obj.Lines)
 	if err != nil {
 		return
 	}
+	delete(m, "lines")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4037,6 +6083,59 @@ type Target struct {
 	// Send notification to the devices that have subscribed to any of
 	//   these tags.
 	TagNames []string `json:"tagNames,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Target
+func (o *Target) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Target
+func (o *Target) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Target
+func (o *Target) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Target
+func (o *Target) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Target
+func (o *Target) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.DeviceIds != nil {
+		m["deviceIds"] = o.DeviceIds
+	}
+	if o.UserIds != nil {
+		m["userIds"] = o.UserIds
+	}
+	if o.Platforms != nil {
+		m["platforms"] = o.Platforms
+	}
+	if o.TagNames != nil {
+		m["tagNames"] = o.TagNames
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalTarget unmarshals an instance of Target from the specified map of raw messages.
@@ -4046,18 +6145,128 @@ func UnmarshalTarget(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	delete(m, "deviceIds")
 	err = core.UnmarshalPrimitive(m, "userIds", &obj.UserIds)
 	if err != nil {
 		return
 	}
+	delete(m, "userIds")
 	err = core.UnmarshalPrimitive(m, "platforms", &obj.Platforms)
 	if err != nil {
 		return
 	}
+	delete(m, "platforms")
 	err = core.UnmarshalPrimitive(m, "tagNames", &obj.TagNames)
 	if err != nil {
 		return
 	}
+	delete(m, "tagNames")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// WebPushAction : An action button displayed with a WebPush Notification.
+type WebPushAction struct {
+	// The identifier of the action, passed to the service worker when the user clicks the button.
+	Action *string `json:"action" validate:"required"`
+
+	// The label of the action button.
+	Title *string `json:"title" validate:"required"`
+
+	// The URL of the icon to be displayed with the action button.
+	IconURL *string `json:"iconUrl,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// NewWebPushAction : Instantiate WebPushAction (Generic Model Constructor)
+func (*PushServiceV1) NewWebPushAction(action string, title string) (model *WebPushAction, err error) {
+	model = &WebPushAction{
+		Action: core.StringPtr(action),
+		Title:  core.StringPtr(title),
+	}
+	err = core.ValidateStruct(model, "required parameters")
+	return
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of WebPushAction
+func (o *WebPushAction) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of WebPushAction
+func (o *WebPushAction) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of WebPushAction
+func (o *WebPushAction) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of WebPushAction
+func (o *WebPushAction) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of WebPushAction
+func (o *WebPushAction) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["action"] = o.Action
+	m["title"] = o.Title
+	if o.IconURL != nil {
+		m["iconUrl"] = o.IconURL
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
+// UnmarshalWebPushAction unmarshals an instance of WebPushAction from the specified map of raw messages.
+func UnmarshalWebPushAction(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(WebPushAction)
+	err = core.UnmarshalPrimitive(m, "action", &obj.Action)
+	if err != nil {
+		return
+	}
+	delete(m, "action")
+	err = core.UnmarshalPrimitive(m, "title", &obj.Title)
+	if err != nil {
+		return
+	}
+	delete(m, "title")
+	err = core.UnmarshalPrimitive(m, "iconUrl", &obj.IconURL)
+	if err != nil {
+		return
+	}
+	delete(m, "iconUrl")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4072,6 +6281,56 @@ type ApnsCertUploadResponse struct {
 
 	// The date until which the certificate is valid.
 	ValidUntil *string `json:"validUntil,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of ApnsCertUploadResponse
+func (o *ApnsCertUploadResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ApnsCertUploadResponse
+func (o *ApnsCertUploadResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ApnsCertUploadResponse
+func (o *ApnsCertUploadResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ApnsCertUploadResponse
+func (o *ApnsCertUploadResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ApnsCertUploadResponse
+func (o *ApnsCertUploadResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Certificate != nil {
+		m["certificate"] = o.Certificate
+	}
+	if o.IsSandBox != nil {
+		m["isSandBox"] = o.IsSandBox
+	}
+	if o.ValidUntil != nil {
+		m["validUntil"] = o.ValidUntil
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalApnsCertUploadResponse unmarshals an instance of ApnsCertUploadResponse from the specified map of raw messages.
@@ -4081,14 +6340,25 @@ func UnmarshalApnsCertUploadResponse(m map[string]json.RawMessage, result interf
 	if err != nil {
 		return
 	}
+	delete(m, "certificate")
 	err = core.UnmarshalPrimitive(m, "isSandBox", &obj.IsSandBox)
 	if err != nil {
 		return
 	}
+	delete(m, "isSandBox")
 	err = core.UnmarshalPrimitive(m, "validUntil", &obj.ValidUntil)
 	if err != nil {
 		return
 	}
+	delete(m, "validUntil")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4109,6 +6379,62 @@ type AppSettingsObjResponse struct {
 
 	// The link to the Firefox webpush configuration.
 	FirefoxWebConf *string `json:"firefoxWebConf,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of AppSettingsObjResponse
+func (o *AppSettingsObjResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of AppSettingsObjResponse
+func (o *AppSettingsObjResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of AppSettingsObjResponse
+func (o *AppSettingsObjResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of AppSettingsObjResponse
+func (o *AppSettingsObjResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of AppSettingsObjResponse
+func (o *AppSettingsObjResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ApnsConf != nil {
+		m["apnsConf"] = o.ApnsConf
+	}
+	if o.GcmConf != nil {
+		m["gcmConf"] = o.GcmConf
+	}
+	if o.ChromeWebConf != nil {
+		m["chromeWebConf"] = o.ChromeWebConf
+	}
+	if o.SafariWebConf != nil {
+		m["safariWebConf"] = o.SafariWebConf
+	}
+	if o.FirefoxWebConf != nil {
+		m["firefoxWebConf"] = o.FirefoxWebConf
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalAppSettingsObjResponse unmarshals an instance of AppSettingsObjResponse from the specified map of raw messages.
@@ -4118,22 +6444,35 @@ func UnmarshalAppSettingsObjResponse(m map[string]json.RawMessage, result interf
 	if err != nil {
 		return
 	}
+	delete(m, "apnsConf")
 	err = core.UnmarshalPrimitive(m, "gcmConf", &obj.GcmConf)
 	if err != nil {
 		return
 	}
+	delete(m, "gcmConf")
 	err = core.UnmarshalPrimitive(m, "chromeWebConf", &obj.ChromeWebConf)
 	if err != nil {
 		return
 	}
+	delete(m, "chromeWebConf")
 	err = core.UnmarshalPrimitive(m, "safariWebConf", &obj.SafariWebConf)
 	if err != nil {
 		return
 	}
+	delete(m, "safariWebConf")
 	err = core.UnmarshalPrimitive(m, "firefoxWebConf", &obj.FirefoxWebConf)
 	if err != nil {
 		return
 	}
+	delete(m, "firefoxWebConf")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4154,6 +6493,62 @@ type SafariCertUploadResponse struct {
 
 	// The URL of the website that should be permitted to subscribe to Safari Push Notifications.
 	WebSiteURL interface{} `json:"webSiteUrl,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of SafariCertUploadResponse
+func (o *SafariCertUploadResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SafariCertUploadResponse
+func (o *SafariCertUploadResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SafariCertUploadResponse
+func (o *SafariCertUploadResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SafariCertUploadResponse
+func (o *SafariCertUploadResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SafariCertUploadResponse
+func (o *SafariCertUploadResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Certificate != nil {
+		m["certificate"] = o.Certificate
+	}
+	if o.WebsiteName != nil {
+		m["websiteName"] = o.WebsiteName
+	}
+	if o.UrlFormatString != nil {
+		m["urlFormatString"] = o.UrlFormatString
+	}
+	if o.WebsitePushID != nil {
+		m["websitePushID"] = o.WebsitePushID
+	}
+	if o.WebSiteURL != nil {
+		m["webSiteUrl"] = o.WebSiteURL
+	}
+	buffer, err = json.Marshal(m)
+	return
 }
 
 // UnmarshalSafariCertUploadResponse unmarshals an instance of SafariCertUploadResponse from the specified map of raw messages.
@@ -4163,22 +6558,35 @@ func UnmarshalSafariCertUploadResponse(m map[string]json.RawMessage, result inte
 	if err != nil {
 		return
 	}
+	delete(m, "certificate")
 	err = core.UnmarshalPrimitive(m, "websiteName", &obj.WebsiteName)
 	if err != nil {
 		return
 	}
+	delete(m, "websiteName")
 	err = core.UnmarshalPrimitive(m, "urlFormatString", &obj.UrlFormatString)
 	if err != nil {
 		return
 	}
+	delete(m, "urlFormatString")
 	err = core.UnmarshalPrimitive(m, "websitePushID", &obj.WebsitePushID)
 	if err != nil {
 		return
 	}
+	delete(m, "websitePushID")
 	err = core.UnmarshalPrimitive(m, "webSiteUrl", &obj.WebSiteURL)
 	if err != nil {
 		return
 	}
+	delete(m, "webSiteUrl")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4197,6 +6605,9 @@ type SendMessageBody struct {
 	// An optional target for the message. Specify one of the target parameters to choose the recipients of the
 	// notification. If no target is specified, a broadcast notification will be sent to all the registered devices.
 	Target *Target `json:"target,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSendMessageBody : Instantiate SendMessageBody (Generic Model Constructor)
@@ -4208,6 +6619,54 @@ func (*PushServiceV1) NewSendMessageBody(message *Message) (model *SendMessageBo
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of SendMessageBody
+func (o *SendMessageBody) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SendMessageBody
+func (o *SendMessageBody) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SendMessageBody
+func (o *SendMessageBody) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SendMessageBody
+func (o *SendMessageBody) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SendMessageBody
+func (o *SendMessageBody) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	m["message"] = o.Message
+	if o.Settings != nil {
+		m["settings"] = o.Settings
+	}
+	if o.Validate != nil {
+		m["validate"] = o.Validate
+	}
+	if o.Target != nil {
+		m["target"] = o.Target
+	}
+	buffer, err = json.Marshal(m)
+	return
+}
+
 // UnmarshalSendMessageBody unmarshals an instance of SendMessageBody from the specified map of raw messages.
 func UnmarshalSendMessageBody(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SendMessageBody)
@@ -4215,18 +6674,30 @@ func UnmarshalSendMessageBody(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	delete(m, "message")
 	err = core.UnmarshalModel(m, "settings", &obj.Settings, UnmarshalSettings)
 	if err != nil {
 		return
 	}
+	delete(m, "settings")
 	err = core.UnmarshalPrimitive(m, "validate", &obj.Validate)
 	if err != nil {
 		return
 	}
+	delete(m, "validate")
 	err = core.UnmarshalModel(m, "target", &obj.Target, UnmarshalTarget)
 	if err != nil {
 		return
 	}
+	delete(m, "target")
+	for k := range m {
+		v, e := unmarshalAdditionalProperty(m[k])
+		if e != nil {
+			err = e
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -158,7 +158,52 @@ var _ = Describe(`PushServiceV1`, func() {
 			url, err = pushservicev1.GetServiceURLForRegion("INVALID_REGION")
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("us-south"))
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = pushservicev1.GetServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://eu-de.imfpush.cloud.ibm.com/imfpush/v1"))
+			url, err = pushservicev1.GetServiceURLForRegion("private.jp-tok")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://private.jp-tok.imfpush.cloud.ibm.com/imfpush/v1"))
+		})
+		It(`Instantiate service client with a region`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "au-syd",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(pushServiceService.GetServiceURL()).To(Equal("https://au-syd.imfpush.cloud.ibm.com/imfpush/v1"))
+		})
+		It(`Instantiate service client with error: Invalid region`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "us-west",
+			})
+			Expect(pushServiceService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+
+			pushServiceService, serviceErr = pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				URL:           "https://pushservicev1/api",
+				Region:        "us-south",
+			})
+			Expect(pushServiceService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+		It(`Create service client using external config and a region`, func() {
+			testEnvironment := map[string]string{
+				"PUSH_SERVICE_URL":       "https://pushservicev1/api",
+				"PUSH_SERVICE_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1UsingExternalConfig(&pushservicev1.PushServiceV1Options{
+				Region: "private.eu-gb",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(pushServiceService.GetServiceURL()).To(Equal("https://private.eu-gb.imfpush.cloud.ibm.com/imfpush/v1"))
 		})
 	})
 	Describe(`GetSettings(getSettingsOptions *GetSettingsOptions) - Operation response error`, func() {
@@ -592,6 +637,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				saveApnsConfOptionsModel.Password = core.StringPtr("testString")
 				saveApnsConfOptionsModel.IsSandBox = core.BoolPtr(true)
 				saveApnsConfOptionsModel.Certificate = CreateMockReader("This is a mock file.")
+				saveApnsConfOptionsModel.Force = core.BoolPtr(true)
 				saveApnsConfOptionsModel.CertificateContentType = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AcceptLanguage = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AppSecret = core.StringPtr("testString")
@@ -654,6 +700,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				saveApnsConfOptionsModel.Password = core.StringPtr("testString")
 				saveApnsConfOptionsModel.IsSandBox = core.BoolPtr(true)
 				saveApnsConfOptionsModel.Certificate = CreateMockReader("This is a mock file.")
+				saveApnsConfOptionsModel.Force = core.BoolPtr(true)
 				saveApnsConfOptionsModel.CertificateContentType = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AcceptLanguage = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AppSecret = core.StringPtr("testString")
@@ -723,6 +770,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				saveApnsConfOptionsModel.Password = core.StringPtr("testString")
 				saveApnsConfOptionsModel.IsSandBox = core.BoolPtr(true)
 				saveApnsConfOptionsModel.Certificate = CreateMockReader("This is a mock file.")
+				saveApnsConfOptionsModel.Force = core.BoolPtr(true)
 				saveApnsConfOptionsModel.CertificateContentType = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AcceptLanguage = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AppSecret = core.StringPtr("testString")
@@ -749,6 +797,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				saveApnsConfOptionsModel.Password = core.StringPtr("testString")
 				saveApnsConfOptionsModel.IsSandBox = core.BoolPtr(true)
 				saveApnsConfOptionsModel.Certificate = CreateMockReader("This is a mock file.")
+				saveApnsConfOptionsModel.Force = core.BoolPtr(true)
 				saveApnsConfOptionsModel.CertificateContentType = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AcceptLanguage = core.StringPtr("testString")
 				saveApnsConfOptionsModel.AppSecret = core.StringPtr("testString")
@@ -5534,6 +5583,12 @@ var _ = Describe(`PushServiceV1`, func() {
 				URL:           "http://pushservicev1modelgenerator.com",
 				Authenticator: &core.NoAuthAuthenticator{},
 			})
+			It(`Invoke NewApnsCriticalSound successfully`, func() {
+				name := "testString"
+				model, err := pushServiceService.NewApnsCriticalSound(name)
+				Expect(model).ToNot(BeNil())
+				Expect(err).To(BeNil())
+			})
 			It(`Invoke NewChromeWebPushCredendialsModel successfully`, func() {
 				apiKey := "testString"
 				webSiteURL := "testString"
@@ -6139,6 +6194,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				sendMessageOptionsModel.SetValidate(true)
 				sendMessageOptionsModel.SetTarget(targetModel)
 				sendMessageOptionsModel.SetAcceptLanguage("testString")
+				sendMessageOptionsModel.SetAppSecret("testString")
 				sendMessageOptionsModel.SetHeaders(map[string]string{"foo": "bar"})
 				Expect(sendMessageOptionsModel).ToNot(BeNil())
 				Expect(sendMessageOptionsModel.ApplicationID).To(Equal(core.StringPtr("testString")))
@@ -6147,6 +6203,7 @@ var _ = Describe(`PushServiceV1`, func() {
 				Expect(sendMessageOptionsModel.Validate).To(Equal(core.BoolPtr(true)))
 				Expect(sendMessageOptionsModel.Target).To(Equal(targetModel))
 				Expect(sendMessageOptionsModel.AcceptLanguage).To(Equal(core.StringPtr("testString")))
+				Expect(sendMessageOptionsModel.AppSecret).To(Equal(core.StringPtr("testString")))
 				Expect(sendMessageOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
 			})
 			It(`Invoke NewSendMessagesInBulkOptions successfully`, func() {
@@ -6373,6 +6430,13 @@ var _ = Describe(`PushServiceV1`, func() {
 				_, err := pushServiceService.NewSendMessageBody(message)
 				Expect(err).ToNot(BeNil())
 			})
+			It(`Invoke NewWebPushAction successfully`, func() {
+				action := "testString"
+				title := "testString"
+				model, err := pushServiceService.NewWebPushAction(action, title)
+				Expect(model).ToNot(BeNil())
+				Expect(err).To(BeNil())
+			})
 		})
 	})
 	Describe(`Utility function tests`, func() {
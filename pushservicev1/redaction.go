@@ -0,0 +1,305 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedValue replaces secrets in redacted text.
+const RedactedValue = "[REDACTED]"
+
+// secretNames are the names, matched case-insensitively, of the headers, JSON fields, query parameters and form fields
+// whose values are secrets.
+const secretNames = `appSecret|clientSecret|password|apiKey|api_key|privateKey|Authorization`
+
+// secretFormFields are the names of the multipart form fields whose values are secrets: those above, and uploaded
+// certificates, which hold a private key.
+const secretFormFields = secretNames + `|certificate`
+
+var secretPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Headers, as dumped in debug logs.
+	{regexp.MustCompile(`(?im)^((?:` + secretNames + `):[ \t]*)[^\r\n]*`), "${1}" + RedactedValue},
+	// JSON string fields.
+	{regexp.MustCompile(`(?i)("(?:` + secretNames + `)"\s*:\s*")(?:[^"\\]|\\.)*(")`), "${1}" + RedactedValue + "${2}"},
+	// Query and form-urlencoded parameters.
+	{regexp.MustCompile(`(?i)([?&](?:` + secretNames + `)=)[^&\s]*`), "${1}" + RedactedValue},
+	// Multipart form fields, up to the next boundary.
+	{regexp.MustCompile(`(?i)(name="(?:` + secretFormFields + `)"[^\r\n]*\r?\n(?:[^\r\n]+\r?\n)*\r?\n)[\s\S]*?(\r?\n--)`), "${1}" + RedactedValue + "${2}"},
+	// Google API keys, such as a GCM apiKey quoted in an error message.
+	{regexp.MustCompile(`AIza[0-9A-Za-z_\-]{35}`), RedactedValue},
+}
+
+// RedactSecrets returns text, such as a dumped request or response or an error message, with the values of app
+// secrets, client secrets, certificate passwords, certificates and API keys replaced by RedactedValue.
+func RedactSecrets(text string) string {
+	for _, secret := range secretPatterns {
+		text = secret.pattern.ReplaceAllString(text, secret.replacement)
+	}
+	return text
+}
+
+// redactError returns err with secrets redacted from its message, or err itself if its message holds no secrets.
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+	if redacted := RedactSecrets(message); redacted != message {
+		return &redactedError{message: redacted, err: err}
+	}
+	return err
+}
+
+// redactedError is an error whose message had secrets redacted. It unwraps to the original error, so that errors.Is
+// and errors.As, such as for context.DeadlineExceeded or a *url.Error, still see through it.
+type redactedError struct {
+	message string
+	err     error
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactingLogger is a core.Logger which redacts secrets from messages before passing them to another logger.
+type redactingLogger struct {
+	core.Logger
+}
+
+// NewRedactingLogger returns a core.Logger which redacts secrets, as RedactSecrets does, from every message before
+// passing it to logger. NewPushServiceV1 wraps the logger of the core library with it when
+// PushServiceV1Options.RedactLogs is set, so that the requests and responses go-sdk-core dumps at debug level do not
+// expose credentials; a logger set later with core.SetLogger should be wrapped too.
+func NewRedactingLogger(logger core.Logger) core.Logger {
+	if _, ok := logger.(*redactingLogger); ok {
+		return logger
+	}
+	return &redactingLogger{Logger: logger}
+}
+
+func (logger *redactingLogger) Log(level core.LogLevel, format string, inserts ...interface{}) {
+	if logger.IsLogLevelEnabled(level) {
+		logger.Logger.Log(level, "%s", RedactSecrets(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+func (logger *redactingLogger) Error(format string, inserts ...interface{}) {
+	logger.Log(core.LevelError, format, inserts...)
+}
+
+func (logger *redactingLogger) Warn(format string, inserts ...interface{}) {
+	logger.Log(core.LevelWarn, format, inserts...)
+}
+
+func (logger *redactingLogger) Info(format string, inserts ...interface{}) {
+	logger.Log(core.LevelInfo, format, inserts...)
+}
+
+func (logger *redactingLogger) Debug(format string, inserts ...interface{}) {
+	logger.Log(core.LevelDebug, format, inserts...)
+}
+
+var redactingLoggerMutex sync.Mutex
+
+// installRedactingLogger wraps the logger of the core library with a redacting logger, unless it already is one.
+func installRedactingLogger() {
+	redactingLoggerMutex.Lock()
+	defer redactingLoggerMutex.Unlock()
+	if _, ok := core.GetLogger().(*redactingLogger); !ok {
+		core.SetLogger(NewRedactingLogger(core.GetLogger()))
+	}
+}
+
+// secretField matches the names of the struct fields whose values are secrets.
+var secretField = regexp.MustCompile(`^(?i:` + secretFormFields + `)$`)
+
+// redactedFields returns the fields of the struct options points to as %+v prints them, with the values of the secret
+// fields and of the secret headers replaced by RedactedValue, for the String methods of the options.
+func redactedFields(options interface{}) string {
+	value := reflect.ValueOf(options)
+	if value.IsNil() {
+		return "<nil>"
+	}
+	value = value.Elem()
+	fields := make([]string, 0, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		fieldValue := value.Field(i)
+		var text string
+		switch {
+		case (fieldValue.Kind() == reflect.Ptr || fieldValue.Kind() == reflect.Interface) && fieldValue.IsNil():
+			text = "<nil>"
+		case secretField.MatchString(field.Name):
+			text = RedactedValue
+			if fieldValue.Kind() == reflect.String && fieldValue.Len() == 0 {
+				text = ""
+			}
+		case fieldValue.Kind() == reflect.Interface:
+			// Such as the authenticator of an AppSecretAuthenticator, which may hold secrets of its own.
+			text = fmt.Sprintf("%T", fieldValue.Interface())
+		case fieldValue.Kind() == reflect.Ptr && fieldValue.Elem().Kind() != reflect.Struct:
+			text = fmt.Sprintf("%v", fieldValue.Elem().Interface())
+		case field.Name == "Headers" && fieldValue.Type() == reflect.TypeOf(map[string]string(nil)):
+			headers := make(map[string]string, fieldValue.Len())
+			for name, header := range fieldValue.Interface().(map[string]string) {
+				if secretField.MatchString(name) {
+					header = RedactedValue
+				}
+				headers[name] = header
+			}
+			text = fmt.Sprintf("%v", headers)
+		default:
+			text = fmt.Sprintf("%+v", fieldValue.Interface())
+		}
+		fields = append(fields, field.Name+":"+text)
+	}
+	return "{" + strings.Join(fields, " ") + "}"
+}
+
+// redactedJSON returns the JSON of model with its secrets redacted, for the String methods of the credential models.
+func redactedJSON(model json.Marshaler) string {
+	buffer, err := model.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("<%s>", err.Error())
+	}
+	return RedactSecrets(string(buffer))
+}
+
+// String returns the model as JSON with the API key redacted, so that printing it with %v or %s does not expose it.
+func (o *GCMCredendialsModel) String() string {
+	return redactedJSON(o)
+}
+
+// GoString returns the same as String, for %#v.
+func (o *GCMCredendialsModel) GoString() string {
+	return o.String()
+}
+
+// String returns the model as JSON with the API key redacted, so that printing it with %v or %s does not expose it.
+func (o *ChromeWebPushCredendialsModel) String() string {
+	return redactedJSON(o)
+}
+
+// GoString returns the same as String, for %#v.
+func (o *ChromeWebPushCredendialsModel) GoString() string {
+	return o.String()
+}
+
+// The options, the AppClient and the authenticators below carry app secrets, client secrets, API keys, certificates
+// or certificate passwords among their fields. Their String and GoString methods all print them through redactedFields,
+// which replaces those values with RedactedValue, so that printing any of them with %v, %+v or %#v, as in a log
+// statement, does not expose the secrets. Go offers no way to declare the methods once for all of the types, hence the
+// one-line pairs.
+
+func (o *DeleteApnsConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteApnsConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *DeleteChromeAppExtConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteChromeAppExtConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *DeleteChromeWebConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteChromeWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *DeleteFirefoxWebConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteFirefoxWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *DeleteGCMConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteGCMConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *DeleteSafariWebConfOptions) String() string   { return redactedFields(o) }
+func (o *DeleteSafariWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetApnsConfOptions) String() string   { return redactedFields(o) }
+func (o *GetApnsConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetChromeAppExtConfOptions) String() string   { return redactedFields(o) }
+func (o *GetChromeAppExtConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetChromeAppExtConfPublicOptions) String() string   { return redactedFields(o) }
+func (o *GetChromeAppExtConfPublicOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetChromeWebConfOptions) String() string   { return redactedFields(o) }
+func (o *GetChromeWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetFirefoxWebConfOptions) String() string   { return redactedFields(o) }
+func (o *GetFirefoxWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetGCMConfOptions) String() string   { return redactedFields(o) }
+func (o *GetGCMConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetGcmConfPublicOptions) String() string   { return redactedFields(o) }
+func (o *GetGcmConfPublicOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetSafariWebConfOptions) String() string   { return redactedFields(o) }
+func (o *GetSafariWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetSettingsOptions) String() string   { return redactedFields(o) }
+func (o *GetSettingsOptions) GoString() string { return redactedFields(o) }
+
+func (o *GetWebpushServerKeyOptions) String() string   { return redactedFields(o) }
+func (o *GetWebpushServerKeyOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveApnsConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveApnsConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveChromeAppExtConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveChromeAppExtConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveChromeWebConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveChromeWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveFirefoxWebConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveFirefoxWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveGCMConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveGCMConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SaveSafariWebConfOptions) String() string   { return redactedFields(o) }
+func (o *SaveSafariWebConfOptions) GoString() string { return redactedFields(o) }
+
+func (o *SendMessageOptions) String() string   { return redactedFields(o) }
+func (o *SendMessageOptions) GoString() string { return redactedFields(o) }
+
+func (o *SendMessagesInBulkOptions) String() string   { return redactedFields(o) }
+func (o *SendMessagesInBulkOptions) GoString() string { return redactedFields(o) }
+
+func (o *AppClient) String() string   { return redactedFields(o) }
+func (o *AppClient) GoString() string { return redactedFields(o) }
+
+func (o *AppSecretAuthenticator) String() string   { return redactedFields(o) }
+func (o *AppSecretAuthenticator) GoString() string { return redactedFields(o) }
+
+func (o *ClientSecretAuthenticator) String() string   { return redactedFields(o) }
+func (o *ClientSecretAuthenticator) GoString() string { return redactedFields(o) }
@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"  // register the GIF format for GenerateIcons
+	_ "image/jpeg" // register the JPEG format for GenerateIcons
+	"image/png"
+	"io"
+	"io/ioutil"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MinSafariIconSourceSize is the minimum width and height of the image GenerateIcons scales the Safari icons from,
+// which is the size of the largest icon.
+const MinSafariIconSourceSize = 256
+
+// safariIconContentType is the content type of the icons set by GenerateIcons.
+const safariIconContentType = "image/png"
+
+// ValidateSafariIcon checks that icon is a PNG image of exactly size x size pixels, as Safari requires.
+func ValidateSafariIcon(icon []byte, size int) error {
+	config, err := png.DecodeConfig(bytes.NewReader(icon))
+	if err != nil {
+		return fmt.Errorf("the icon is not a PNG image: %s", err.Error())
+	}
+	if config.Width != size || config.Height != size {
+		return fmt.Errorf("the icon must be %dx%d pixels, got %dx%d", size, size, config.Width, config.Height)
+	}
+	return nil
+}
+
+// safariIcon is one icon of the iconset held by SaveSafariWebConfOptions.
+type safariIcon struct {
+	name        string
+	size        int
+	icon        *io.ReadCloser
+	contentType **string
+}
+
+func (options *SaveSafariWebConfOptions) icons() []safariIcon {
+	return []safariIcon{
+		{"icon_16x16", 16, &options.Icon16x16, &options.Icon16x16ContentType},
+		{"icon_16x16@2x", 32, &options.Icon16x162x, &options.Icon16x162xContentType},
+		{"icon_32x32", 32, &options.Icon32x32, &options.Icon32x32ContentType},
+		{"icon_32x32@2x", 64, &options.Icon32x322x, &options.Icon32x322xContentType},
+		{"icon_128x128", 128, &options.Icon128x128, &options.Icon128x128ContentType},
+		{"icon_128x128@2x", 256, &options.Icon128x1282x, &options.Icon128x1282xContentType},
+	}
+}
+
+// ValidateIcons checks that each icon which is set is a PNG image of the size its name requires. The icons are read
+// and closed, and replaced by readers over the same bytes so that the options can still be used to upload them.
+func (options *SaveSafariWebConfOptions) ValidateIcons() error {
+	for _, icon := range options.icons() {
+		if *icon.icon == nil {
+			continue
+		}
+		content, err := ioutil.ReadAll(*icon.icon)
+		(*icon.icon).Close()
+		if err != nil {
+			return fmt.Errorf("safariWeb: unable to read %s: %s", icon.name, err.Error())
+		}
+		*icon.icon = ioutil.NopCloser(bytes.NewReader(content))
+
+		if err = ValidateSafariIcon(content, icon.size); err != nil {
+			return fmt.Errorf("safariWeb: %s: %s", icon.name, err.Error())
+		}
+	}
+	return nil
+}
+
+// GenerateIcons scales a square source image in PNG, JPEG or GIF format to the six sizes of the Safari iconset and sets
+// them, replacing any icons already set. The source must be at least MinSafariIconSourceSize pixels wide.
+func (options *SaveSafariWebConfOptions) GenerateIcons(source io.Reader) error {
+	sourceImage, _, err := image.Decode(source)
+	if err != nil {
+		return fmt.Errorf("safariWeb: unable to decode the source image: %s", err.Error())
+	}
+	bounds := sourceImage.Bounds()
+	if bounds.Dx() != bounds.Dy() {
+		return fmt.Errorf("safariWeb: the source image must be square, got %dx%d", bounds.Dx(), bounds.Dy())
+	}
+	if bounds.Dx() < MinSafariIconSourceSize {
+		return fmt.Errorf("safariWeb: the source image must be at least %dx%d pixels, got %dx%d",
+			MinSafariIconSourceSize, MinSafariIconSourceSize, bounds.Dx(), bounds.Dy())
+	}
+
+	// Scaling works on premultiplied alpha so that transparent pixels do not bleed their color into the edges.
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), sourceImage, bounds.Min, draw.Src)
+
+	icons := options.icons()
+	encoded := make([][]byte, len(icons))
+	for i, icon := range icons {
+		var buffer bytes.Buffer
+		if err = png.Encode(&buffer, scaleImage(rgba, icon.size)); err != nil {
+			return fmt.Errorf("safariWeb: unable to encode %s: %s", icon.name, err.Error())
+		}
+		encoded[i] = buffer.Bytes()
+	}
+	for i, icon := range icons {
+		*icon.icon = ioutil.NopCloser(bytes.NewReader(encoded[i]))
+		*icon.contentType = core.StringPtr(safariIconContentType)
+	}
+	return nil
+}
+
+// scaleImage shrinks a square image to size x size pixels by averaging the source pixels each target pixel covers.
+func scaleImage(source *image.RGBA, size int) *image.RGBA {
+	sourceSize := source.Bounds().Dx()
+	weights := areaWeights(sourceSize, size)
+
+	// Scale the rows first, then the columns of the result.
+	rows := make([]float64, sourceSize*size*4)
+	for y := 0; y < sourceSize; y++ {
+		for x, contributions := range weights {
+			var sum [4]float64
+			for _, c := range contributions {
+				offset := source.PixOffset(c.index, y)
+				for channel := 0; channel < 4; channel++ {
+					sum[channel] += float64(source.Pix[offset+channel]) * c.weight
+				}
+			}
+			copy(rows[(y*size+x)*4:], sum[:])
+		}
+	}
+
+	target := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y, contributions := range weights {
+		for x := 0; x < size; x++ {
+			var sum [4]float64
+			for _, c := range contributions {
+				offset := (c.index*size + x) * 4
+				for channel := 0; channel < 4; channel++ {
+					sum[channel] += rows[offset+channel] * c.weight
+				}
+			}
+			offset := target.PixOffset(x, y)
+			for channel := 0; channel < 4; channel++ {
+				target.Pix[offset+channel] = uint8(sum[channel] + 0.5)
+			}
+		}
+	}
+	return target
+}
+
+// areaContribution is the share of one source pixel in a target pixel.
+type areaContribution struct {
+	index  int
+	weight float64
+}
+
+// areaWeights returns, for each of the targetSize pixels of a line, the source pixels it covers and their weights.
+func areaWeights(sourceSize int, targetSize int) [][]areaContribution {
+	scale := float64(sourceSize) / float64(targetSize)
+	weights := make([][]areaContribution, targetSize)
+	for i := range weights {
+		start := float64(i) * scale
+		end := start + scale
+		for index := int(start); index < sourceSize && float64(index) < end; index++ {
+			covered := minFloat(end, float64(index+1)) - maxFloat(start, float64(index))
+			if covered > 0 {
+				weights[i] = append(weights[i], areaContribution{index, covered / scale})
+			}
+		}
+	}
+	return weights
+}
+
+func minFloat(a float64, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a float64, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
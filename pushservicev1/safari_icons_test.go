@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Safari icons`, func() {
+	// newImage returns a width x height image whose left half is opaque red and right half is transparent.
+	newImage := func(width int, height int) *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width/2; x++ {
+				img.Set(x, y, color.NRGBA{R: 255, A: 255})
+			}
+		}
+		return img
+	}
+	encodePNG := func(img image.Image) []byte {
+		var buffer bytes.Buffer
+		Expect(png.Encode(&buffer, img)).To(BeNil())
+		return buffer.Bytes()
+	}
+	pushServiceService, _ := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	newSaveSafariWebConfOptions := func() *pushservicev1.SaveSafariWebConfOptions {
+		return pushServiceService.NewSaveSafariWebConfOptions("testString", "testString", CreateMockReader("This is a mock file."),
+			"testString", "https://example.com/%@", "web.com.example", "https://example.com")
+	}
+
+	Describe(`ValidateSafariIcon(icon []byte, size int)`, func() {
+		It(`Accepts a PNG of the expected size only`, func() {
+			Expect(pushservicev1.ValidateSafariIcon(encodePNG(newImage(32, 32)), 32)).To(BeNil())
+			Expect(pushservicev1.ValidateSafariIcon(encodePNG(newImage(32, 32)), 16)).ToNot(BeNil())
+			Expect(pushservicev1.ValidateSafariIcon(encodePNG(newImage(32, 16)), 32)).ToNot(BeNil())
+			Expect(pushservicev1.ValidateSafariIcon([]byte("This is a mock file."), 32)).ToNot(BeNil())
+		})
+	})
+	Describe(`ValidateIcons()`, func() {
+		It(`Validates the icons that are set and keeps them readable`, func() {
+			options := newSaveSafariWebConfOptions().
+				SetIcon16x16(ioutil.NopCloser(bytes.NewReader(encodePNG(newImage(16, 16))))).
+				SetIcon128x1282x(ioutil.NopCloser(bytes.NewReader(encodePNG(newImage(256, 256)))))
+			Expect(options.ValidateIcons()).To(BeNil())
+
+			content, err := ioutil.ReadAll(options.Icon128x1282x)
+			Expect(err).To(BeNil())
+			Expect(pushservicev1.ValidateSafariIcon(content, 256)).To(BeNil())
+		})
+		It(`Rejects an icon of the wrong size`, func() {
+			options := newSaveSafariWebConfOptions().
+				SetIcon32x322x(ioutil.NopCloser(bytes.NewReader(encodePNG(newImage(32, 32)))))
+			err := options.ValidateIcons()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("icon_32x32@2x"))
+		})
+	})
+	Describe(`GenerateIcons(source io.Reader)`, func() {
+		It(`Generates the six icons from a PNG`, func() {
+			options := newSaveSafariWebConfOptions()
+			Expect(options.GenerateIcons(bytes.NewReader(encodePNG(newImage(512, 512))))).To(BeNil())
+			Expect(options.Icon16x16ContentType).To(Equal(core.StringPtr("image/png")))
+			Expect(options.Icon128x1282xContentType).To(Equal(core.StringPtr("image/png")))
+
+			content, err := ioutil.ReadAll(options.Icon16x16)
+			Expect(err).To(BeNil())
+			icon, err := png.Decode(bytes.NewReader(content))
+			Expect(err).To(BeNil())
+			Expect(icon.Bounds().Dx()).To(Equal(16))
+			// The halves are kept apart, without the transparent half darkening the red one.
+			Expect(color.NRGBAModel.Convert(icon.At(0, 0))).To(Equal(color.NRGBA{R: 255, A: 255}))
+			Expect(color.NRGBAModel.Convert(icon.At(15, 15)).(color.NRGBA).A).To(Equal(uint8(0)))
+
+			options.Icon16x16 = ioutil.NopCloser(bytes.NewReader(content))
+			Expect(options.ValidateIcons()).To(BeNil())
+		})
+		It(`Generates the icons from a JPEG whose size is not a multiple of the icon sizes`, func() {
+			var buffer bytes.Buffer
+			Expect(jpeg.Encode(&buffer, newImage(300, 300), nil)).To(BeNil())
+
+			options := newSaveSafariWebConfOptions()
+			Expect(options.GenerateIcons(&buffer)).To(BeNil())
+			Expect(options.ValidateIcons()).To(BeNil())
+		})
+		It(`Rejects a small, non square or invalid source image`, func() {
+			options := newSaveSafariWebConfOptions()
+			Expect(options.GenerateIcons(bytes.NewReader(encodePNG(newImage(128, 128))))).ToNot(BeNil())
+			Expect(options.GenerateIcons(bytes.NewReader(encodePNG(newImage(512, 256))))).ToNot(BeNil())
+			Expect(options.GenerateIcons(bytes.NewReader([]byte("This is a mock file.")))).ToNot(BeNil())
+			Expect(options.Icon16x16).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// safariURLPlaceholder is the token in a Safari urlFormatString that is replaced by the next urlArgs element.
+const safariURLPlaceholder = "%@"
+
+// safariURLFormatTTL is how long a fetched urlFormatString is trusted before it is fetched again.
+const safariURLFormatTTL = 5 * time.Minute
+
+// CountSafariURLPlaceholders returns the number of %@ placeholders in a Safari urlFormatString.
+func CountSafariURLPlaceholders(urlFormatString string) int {
+	return strings.Count(urlFormatString, safariURLPlaceholder)
+}
+
+// RenderSafariClickURL substitutes urlArgs, in order, into the %@ placeholders of urlFormatString and returns the URL
+// Safari opens when the notification is clicked. An error is returned if the number of arguments does not match the
+// number of placeholders, since Safari does not open the URL in that case.
+func RenderSafariClickURL(urlFormatString string, urlArgs []string) (string, error) {
+	placeholders := CountSafariURLPlaceholders(urlFormatString)
+	if placeholders != len(urlArgs) {
+		return "", fmt.Errorf("safariWeb: urlFormatString '%s' has %d placeholders but %d urlArgs were provided",
+			urlFormatString, placeholders, len(urlArgs))
+	}
+
+	parts := strings.Split(urlFormatString, safariURLPlaceholder)
+	var clickURL strings.Builder
+	for i, part := range parts {
+		clickURL.WriteString(part)
+		if i < len(urlArgs) {
+			clickURL.WriteString(urlArgs[i])
+		}
+	}
+	return clickURL.String(), nil
+}
+
+// ValidateSafariURLArgs checks that urlArgs matches the number of %@ placeholders in the urlFormatString of the
+// application's Safari configuration.
+func (pushService *PushServiceV1) ValidateSafariURLArgs(applicationID string, urlArgs []string) error {
+	return pushService.ValidateSafariURLArgsWithContext(context.Background(), applicationID, urlArgs)
+}
+
+// ValidateSafariURLArgsWithContext is an alternate form of the ValidateSafariURLArgs method which supports a Context parameter
+func (pushService *PushServiceV1) ValidateSafariURLArgsWithContext(ctx context.Context, applicationID string, urlArgs []string) error {
+	_, err := pushService.PreviewSafariClickURLWithContext(ctx, applicationID, urlArgs)
+	return err
+}
+
+// PreviewSafariClickURL returns the URL Safari would open for a notification sent to applicationID with urlArgs.
+func (pushService *PushServiceV1) PreviewSafariClickURL(applicationID string, urlArgs []string) (string, error) {
+	return pushService.PreviewSafariClickURLWithContext(context.Background(), applicationID, urlArgs)
+}
+
+// PreviewSafariClickURLWithContext is an alternate form of the PreviewSafariClickURL method which supports a Context parameter
+func (pushService *PushServiceV1) PreviewSafariClickURLWithContext(ctx context.Context, applicationID string, urlArgs []string) (string, error) {
+	urlFormatString, err := pushService.getSafariURLFormatString(ctx, applicationID)
+	if err != nil {
+		return "", err
+	}
+	return RenderSafariClickURL(urlFormatString, urlArgs)
+}
+
+// getSafariURLFormatString returns the urlFormatString of the application's Safari configuration, from the cache when
+// a recent copy is available.
+func (pushService *PushServiceV1) getSafariURLFormatString(ctx context.Context, applicationID string) (string, error) {
+	if urlFormatString, ok := pushService.safariURLFormats.get(applicationID); ok {
+		return urlFormatString, nil
+	}
+
+	result, _, err := pushService.GetSafariWebConfWithContext(ctx, &GetSafariWebConfOptions{
+		ApplicationID: &applicationID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("safariWeb: unable to retrieve the Safari configuration to check urlArgs: %s", err.Error())
+	}
+	if result.UrlFormatString == nil {
+		return "", fmt.Errorf("safariWeb: the Safari configuration of application '%s' has no urlFormatString", applicationID)
+	}
+
+	pushService.safariURLFormats.put(applicationID, *result.UrlFormatString)
+	return *result.UrlFormatString, nil
+}
+
+// safariURLFormatCache holds recently fetched Safari urlFormatStrings. A nil cache is valid and caches nothing.
+type safariURLFormatCache struct {
+	mutex   sync.Mutex
+	entries map[string]safariURLFormatEntry
+}
+
+type safariURLFormatEntry struct {
+	urlFormatString string
+	expires         time.Time
+}
+
+func newSafariURLFormatCache() *safariURLFormatCache {
+	return &safariURLFormatCache{
+		entries: make(map[string]safariURLFormatEntry),
+	}
+}
+
+func (cache *safariURLFormatCache) get(applicationID string) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry, ok := cache.entries[applicationID]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	return entry.urlFormatString, true
+}
+
+func (cache *safariURLFormatCache) put(applicationID string, urlFormatString string) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.entries[applicationID] = safariURLFormatEntry{
+		urlFormatString: urlFormatString,
+		expires:         time.Now().Add(safariURLFormatTTL),
+	}
+}
+
+func (cache *safariURLFormatCache) invalidate(applicationID string) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	delete(cache.entries, applicationID)
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Safari urlArgs validation`, func() {
+	Describe(`RenderSafariClickURL(urlFormatString string, urlArgs []string)`, func() {
+		It(`Substitutes the arguments in order`, func() {
+			Expect(pushservicev1.CountSafariURLPlaceholders("https://example.com/%@/items/%@")).To(Equal(2))
+
+			clickURL, err := pushservicev1.RenderSafariClickURL("https://example.com/%@/items/%@", []string{"shop", "42"})
+			Expect(err).To(BeNil())
+			Expect(clickURL).To(Equal("https://example.com/shop/items/42"))
+
+			clickURL, err = pushservicev1.RenderSafariClickURL("https://example.com/", nil)
+			Expect(err).To(BeNil())
+			Expect(clickURL).To(Equal("https://example.com/"))
+		})
+		It(`Rejects a mismatched number of arguments`, func() {
+			_, err := pushservicev1.RenderSafariClickURL("https://example.com/%@", []string{"a", "b"})
+			Expect(err).ToNot(BeNil())
+			_, err = pushservicev1.RenderSafariClickURL("https://example.com/%@/%@", []string{"a"})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`SendMessage(sendMessageOptions *SendMessageOptions) - Safari urlArgs validation`, func() {
+		getSafariWebConfPath := "/apps/testString/settings/safariWebConf"
+		sendMessagePath := "/apps/testString/messages"
+		var testServer *httptest.Server
+		var confRequests int
+		var sendRequests int
+		var deleteStatus int
+		BeforeEach(func() {
+			confRequests = 0
+			deleteStatus = 204
+			sendRequests = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case getSafariWebConfPath:
+					if req.Method == "DELETE" {
+						res.WriteHeader(deleteStatus)
+						return
+					}
+					Expect(req.Method).To(Equal("GET"))
+					confRequests++
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"certificate": "Certificate", "websiteName": "WebsiteName", "urlFormatString": "https://example.com/%@/%@"}`)
+				case sendMessagePath, sendMessagePath + "/bulk":
+					sendRequests++
+					res.WriteHeader(202)
+					fmt.Fprintf(res, "%s", `{"messageId": "MessageID"}`)
+				default:
+					res.WriteHeader(404)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newSendMessageOptions := func(pushServiceService *pushservicev1.PushServiceV1, urlArgs []string) *pushservicev1.SendMessageOptions {
+			return pushServiceService.NewSendMessageOptions("testString", &pushservicev1.Message{Alert: core.StringPtr("testString")}).
+				SetSettings(&pushservicev1.Settings{
+					SafariWeb: &pushservicev1.SafariWeb{Title: core.StringPtr("testString"), UrlArgs: urlArgs},
+				})
+		}
+		It(`Invoke SendMessage with matching and mismatched urlArgs`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:                   testServer.URL,
+				Authenticator:         &core.NoAuthAuthenticator{},
+				ValidateSafariURLArgs: true,
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, response, operationErr := pushServiceService.SendMessage(newSendMessageOptions(pushServiceService, []string{"a", "b"}))
+			Expect(operationErr).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(result).ToNot(BeNil())
+
+			result, response, operationErr = pushServiceService.SendMessage(newSendMessageOptions(pushServiceService, []string{"a"}))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+
+			// The Safari configuration is fetched once and reused for the second message.
+			Expect(confRequests).To(Equal(1))
+			Expect(sendRequests).To(Equal(1))
+		})
+		It(`Invoke SendMessagesInBulk with a mismatched urlArgs in one message`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:                   testServer.URL,
+				Authenticator:         &core.NoAuthAuthenticator{},
+				ValidateSafariURLArgs: true,
+			})
+			Expect(serviceErr).To(BeNil())
+
+			messageBody := func(urlArgs []string) pushservicev1.SendMessageBody {
+				options := newSendMessageOptions(pushServiceService, urlArgs)
+				return pushservicev1.SendMessageBody{Message: options.Message, Settings: options.Settings}
+			}
+			_, response, operationErr := pushServiceService.SendMessagesInBulk(pushServiceService.NewSendMessagesInBulkOptions("testString",
+				[]pushservicev1.SendMessageBody{messageBody([]string{"a", "b"}), messageBody([]string{"a"})}))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(sendRequests).To(Equal(0))
+
+			_, _, operationErr = pushServiceService.SendMessagesInBulk(pushServiceService.NewSendMessagesInBulkOptions("testString",
+				[]pushservicev1.SendMessageBody{messageBody([]string{"a", "b"}), messageBody([]string{"c", "d"})}))
+			Expect(operationErr).To(BeNil())
+			Expect(confRequests).To(Equal(1))
+			Expect(sendRequests).To(Equal(1))
+		})
+		It(`Invoke SendMessage without validation enabled`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			_, _, operationErr := pushServiceService.SendMessage(newSendMessageOptions(pushServiceService, []string{"a"}))
+			Expect(operationErr).To(BeNil())
+			Expect(confRequests).To(Equal(0))
+			Expect(sendRequests).To(Equal(1))
+		})
+		It(`Invoke PreviewSafariClickURL and refetch after DeleteSafariWebConf`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			clickURL, err := pushServiceService.PreviewSafariClickURL("testString", []string{"shop", "42"})
+			Expect(err).To(BeNil())
+			Expect(clickURL).To(Equal("https://example.com/shop/42"))
+			Expect(pushServiceService.ValidateSafariURLArgs("testString", []string{"shop"})).ToNot(BeNil())
+			Expect(confRequests).To(Equal(1))
+
+			// A successful delete invalidates the cached configuration.
+			_, err = pushServiceService.DeleteSafariWebConf(pushServiceService.NewDeleteSafariWebConfOptions("testString"))
+			Expect(err).To(BeNil())
+			Expect(pushServiceService.ValidateSafariURLArgs("testString", []string{"shop", "42"})).To(BeNil())
+			Expect(confRequests).To(Equal(2))
+		})
+		It(`Invoke PreviewSafariClickURL and refetch after a failed DeleteSafariWebConf`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			Expect(pushServiceService.ValidateSafariURLArgs("testString", []string{"shop", "42"})).To(BeNil())
+			Expect(confRequests).To(Equal(1))
+
+			// The delete may have taken effect even though it failed, such as when the response was lost.
+			deleteStatus = 500
+			_, err := pushServiceService.DeleteSafariWebConf(pushServiceService.NewDeleteSafariWebConfOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(pushServiceService.ValidateSafariURLArgs("testString", []string{"shop", "42"})).To(BeNil())
+			Expect(confRequests).To(Equal(2))
+		})
+		It(`Invoke ValidateSafariURLArgs when the configuration cannot be retrieved`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			Expect(pushServiceService.ValidateSafariURLArgs("unknownApp", []string{"a", "b"})).ToNot(BeNil())
+		})
+	})
+})
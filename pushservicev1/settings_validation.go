@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"fmt"
+)
+
+// MaxWebPushActions is the maximum number of action buttons browsers display with a WebPush Notification.
+const MaxWebPushActions = 2
+
+// maxWebPushTopicLength is the maximum length of the Web Push Topic header, as defined by RFC 8030.
+const maxWebPushTopicLength = 32
+
+// Validate checks the platform specific settings for values and combinations of values which the push service or the
+// target platform would reject. A nil Settings is valid.
+func (settings *Settings) Validate() error {
+	if settings == nil {
+		return nil
+	}
+	if settings.Apns != nil {
+		if err := settings.Apns.Validate(); err != nil {
+			return err
+		}
+	}
+	if settings.ChromeWeb != nil {
+		if err := settings.ChromeWeb.Validate(); err != nil {
+			return err
+		}
+	}
+	if settings.FirefoxWeb != nil {
+		if err := settings.FirefoxWeb.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the iOS settings for out of range values and for combinations which APNs does not accept.
+func (apns *Apns) Validate() error {
+	if apns.InterruptionLevel != nil {
+		switch *apns.InterruptionLevel {
+		case Apns_InterruptionLevel_Active, Apns_InterruptionLevel_Critical,
+			Apns_InterruptionLevel_Passive, Apns_InterruptionLevel_TimeSensitive:
+		default:
+			return fmt.Errorf("apns: invalid interruptionLevel '%s'", *apns.InterruptionLevel)
+		}
+	}
+	if apns.RelevanceScore != nil && (*apns.RelevanceScore < 0 || *apns.RelevanceScore > 1) {
+		return fmt.Errorf("apns: relevanceScore must be between 0 and 1, got %v", *apns.RelevanceScore)
+	}
+	if apns.ApnsExpiration != nil && *apns.ApnsExpiration < 0 {
+		return fmt.Errorf("apns: apnsExpiration must not be negative, got %d", *apns.ApnsExpiration)
+	}
+	if apns.ApnsPriority != nil {
+		switch *apns.ApnsPriority {
+		case 1, 5, 10:
+		default:
+			return fmt.Errorf("apns: apnsPriority must be 1, 5 or 10, got %d", *apns.ApnsPriority)
+		}
+	}
+
+	if apns.CriticalSound != nil {
+		if err := apns.CriticalSound.Validate(); err != nil {
+			return err
+		}
+		if apns.Sound != nil {
+			return fmt.Errorf("apns: sound and criticalSound are mutually exclusive")
+		}
+		if apns.CriticalSound.Critical != nil && *apns.CriticalSound.Critical == 1 &&
+			apns.InterruptionLevel != nil && *apns.InterruptionLevel != Apns_InterruptionLevel_Critical {
+			return fmt.Errorf("apns: a critical sound requires interruptionLevel '%s', got '%s'",
+				Apns_InterruptionLevel_Critical, *apns.InterruptionLevel)
+		}
+	}
+
+	if apns.ApnsPushType != nil {
+		switch *apns.ApnsPushType {
+		case Apns_ApnsPushType_Alert, Apns_ApnsPushType_Liveactivity, Apns_ApnsPushType_Voip:
+		case Apns_ApnsPushType_Background:
+			return apns.validateBackground()
+		default:
+			return fmt.Errorf("apns: invalid apnsPushType '%s'", *apns.ApnsPushType)
+		}
+	}
+	return nil
+}
+
+// validateBackground checks that a background push carries nothing the user would see or hear, as APNs requires.
+func (apns *Apns) validateBackground() error {
+	if apns.Title != nil || apns.Subtitle != nil || apns.Sound != nil || apns.CriticalSound != nil || apns.Badge != nil {
+		return fmt.Errorf("apns: a background push must not include title, subtitle, sound, criticalSound or badge")
+	}
+	if apns.MutableContent != nil && *apns.MutableContent {
+		return fmt.Errorf("apns: a background push must not set mutableContent")
+	}
+	if apns.InterruptionLevel != nil {
+		return fmt.Errorf("apns: a background push must not set interruptionLevel")
+	}
+	if apns.ApnsPriority != nil && *apns.ApnsPriority == 10 {
+		return fmt.Errorf("apns: a background push must be sent with apnsPriority 5 or 1")
+	}
+	return nil
+}
+
+// Validate checks that the critical alert sound names a sound file and that its volume is within range.
+func (sound *ApnsCriticalSound) Validate() error {
+	if sound.Name == nil || *sound.Name == "" {
+		return fmt.Errorf("apns: criticalSound.name is required")
+	}
+	if sound.Critical != nil && *sound.Critical != 0 && *sound.Critical != 1 {
+		return fmt.Errorf("apns: criticalSound.critical must be 0 or 1, got %d", *sound.Critical)
+	}
+	if sound.Volume != nil && (*sound.Volume < 0 || *sound.Volume > 1) {
+		return fmt.Errorf("apns: criticalSound.volume must be between 0 and 1, got %v", *sound.Volume)
+	}
+	return nil
+}
+
+// Validate checks the Chrome WebPush settings against the limits of the Notification API and the Web Push protocol.
+func (chromeWeb *ChromeWeb) Validate() error {
+	return webPushSettings{
+		platform:  "chromeWeb",
+		actions:   chromeWeb.Actions,
+		silent:    chromeWeb.Silent,
+		vibrate:   chromeWeb.Vibrate,
+		tag:       chromeWeb.Tag,
+		renotify:  chromeWeb.Renotify,
+		urgency:   chromeWeb.Urgency,
+		urgencies: chromeWebUrgencies,
+		topic:     chromeWeb.Topic,
+	}.validate()
+}
+
+// Validate checks the Firefox WebPush settings against the limits of the Notification API and the Web Push protocol.
+func (firefoxWeb *FirefoxWeb) Validate() error {
+	return webPushSettings{
+		platform:  "firefoxWeb",
+		actions:   firefoxWeb.Actions,
+		silent:    firefoxWeb.Silent,
+		vibrate:   firefoxWeb.Vibrate,
+		tag:       firefoxWeb.Tag,
+		renotify:  firefoxWeb.Renotify,
+		urgency:   firefoxWeb.Urgency,
+		urgencies: firefoxWebUrgencies,
+		topic:     firefoxWeb.Topic,
+	}.validate()
+}
+
+// The urgencies of the Web Push protocol, as each platform names them.
+var (
+	chromeWebUrgencies  = []string{ChromeWeb_Urgency_VeryLow, ChromeWeb_Urgency_Low, ChromeWeb_Urgency_Normal, ChromeWeb_Urgency_High}
+	firefoxWebUrgencies = []string{FirefoxWeb_Urgency_VeryLow, FirefoxWeb_Urgency_Low, FirefoxWeb_Urgency_Normal, FirefoxWeb_Urgency_High}
+)
+
+// webPushSettings holds the fields shared by ChromeWeb and FirefoxWeb so that both are checked by the same rules.
+type webPushSettings struct {
+	platform string
+	actions  []WebPushAction
+	silent   *bool
+	vibrate  []int64
+	tag      *string
+	renotify *bool
+	urgency  *string
+	topic    *string
+
+	// The values urgency may take, the *_Urgency_* constants of the platform.
+	urgencies []string
+}
+
+func (settings webPushSettings) validate() error {
+	if len(settings.actions) > MaxWebPushActions {
+		return fmt.Errorf("%s: at most %d actions are supported, got %d", settings.platform, MaxWebPushActions, len(settings.actions))
+	}
+	for i, action := range settings.actions {
+		if action.Action == nil || *action.Action == "" || action.Title == nil || *action.Title == "" {
+			return fmt.Errorf("%s: actions[%d] requires both action and title", settings.platform, i)
+		}
+	}
+	if settings.renotify != nil && *settings.renotify && (settings.tag == nil || *settings.tag == "") {
+		return fmt.Errorf("%s: renotify requires a tag", settings.platform)
+	}
+	if settings.silent != nil && *settings.silent && len(settings.vibrate) > 0 {
+		return fmt.Errorf("%s: a silent notification must not set vibrate", settings.platform)
+	}
+	for _, duration := range settings.vibrate {
+		if duration < 0 {
+			return fmt.Errorf("%s: vibrate durations must not be negative, got %d", settings.platform, duration)
+		}
+	}
+	if settings.urgency != nil && !containsString(settings.urgencies, *settings.urgency) {
+		return fmt.Errorf("%s: invalid urgency '%s'", settings.platform, *settings.urgency)
+	}
+	if settings.topic != nil {
+		if err := validateWebPushTopic(*settings.topic); err != nil {
+			return fmt.Errorf("%s: %s", settings.platform, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateWebPushTopic checks that the topic is at most 32 characters from the URL and filename safe base64 alphabet.
+func validateWebPushTopic(topic string) error {
+	if topic == "" || len(topic) > maxWebPushTopicLength {
+		return fmt.Errorf("topic must be between 1 and %d characters long", maxWebPushTopicLength)
+	}
+	for _, c := range topic {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return fmt.Errorf("topic may only contain URL-safe base64 characters, got '%c'", c)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
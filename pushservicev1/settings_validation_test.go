@@ -0,0 +1,278 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Settings validation`, func() {
+	Describe(`Apns.Validate()`, func() {
+		It(`Accepts a fully populated alert notification`, func() {
+			apnsModel := new(pushservicev1.Apns)
+			apnsModel.Title = core.StringPtr("testString")
+			apnsModel.InterruptionLevel = core.StringPtr(pushservicev1.Apns_InterruptionLevel_TimeSensitive)
+			apnsModel.RelevanceScore = core.Float64Ptr(0.75)
+			apnsModel.TargetContentID = core.StringPtr("testString")
+			apnsModel.MutableContent = core.BoolPtr(true)
+			apnsModel.ApnsPushType = core.StringPtr(pushservicev1.Apns_ApnsPushType_Alert)
+			apnsModel.ApnsExpiration = core.Int64Ptr(int64(0))
+			apnsModel.ApnsPriority = core.Int64Ptr(int64(10))
+			Expect(apnsModel.Validate()).To(BeNil())
+		})
+		It(`Accepts a critical alert`, func() {
+			apnsModel := new(pushservicev1.Apns)
+			apnsModel.InterruptionLevel = core.StringPtr(pushservicev1.Apns_InterruptionLevel_Critical)
+			apnsModel.CriticalSound = &pushservicev1.ApnsCriticalSound{
+				Critical: core.Int64Ptr(int64(1)),
+				Name:     core.StringPtr("alarm.caf"),
+				Volume:   core.Float64Ptr(1.0),
+			}
+			Expect(apnsModel.Validate()).To(BeNil())
+		})
+		It(`Accepts a background push without visible content`, func() {
+			apnsModel := new(pushservicev1.Apns)
+			apnsModel.ApnsPushType = core.StringPtr(pushservicev1.Apns_ApnsPushType_Background)
+			apnsModel.ApnsPriority = core.Int64Ptr(int64(5))
+			apnsModel.Payload = map[string]interface{}{"anyKey": "anyValue"}
+			Expect(apnsModel.Validate()).To(BeNil())
+		})
+		It(`Rejects out of range and unknown values`, func() {
+			invalid := []*pushservicev1.Apns{
+				{InterruptionLevel: core.StringPtr("urgent")},
+				{RelevanceScore: core.Float64Ptr(1.5)},
+				{RelevanceScore: core.Float64Ptr(-0.1)},
+				{ApnsExpiration: core.Int64Ptr(int64(-1))},
+				{ApnsPriority: core.Int64Ptr(int64(7))},
+				{ApnsPushType: core.StringPtr("complication")},
+			}
+			for _, apnsModel := range invalid {
+				Expect(apnsModel.Validate()).ToNot(BeNil())
+			}
+		})
+		It(`Rejects invalid critical sounds`, func() {
+			invalid := []*pushservicev1.ApnsCriticalSound{
+				{Volume: core.Float64Ptr(0.5)},
+				{Name: core.StringPtr("alarm.caf"), Volume: core.Float64Ptr(1.01)},
+				{Name: core.StringPtr("alarm.caf"), Volume: core.Float64Ptr(-1)},
+				{Name: core.StringPtr("alarm.caf"), Critical: core.Int64Ptr(int64(2))},
+			}
+			for _, criticalSound := range invalid {
+				apnsModel := &pushservicev1.Apns{CriticalSound: criticalSound}
+				Expect(apnsModel.Validate()).ToNot(BeNil())
+			}
+		})
+		It(`Rejects sound together with criticalSound`, func() {
+			apnsModel := new(pushservicev1.Apns)
+			apnsModel.Sound = core.StringPtr("default")
+			apnsModel.CriticalSound = &pushservicev1.ApnsCriticalSound{Name: core.StringPtr("alarm.caf")}
+			Expect(apnsModel.Validate()).ToNot(BeNil())
+		})
+		It(`Rejects a critical sound with a non-critical interruption level`, func() {
+			apnsModel := new(pushservicev1.Apns)
+			apnsModel.InterruptionLevel = core.StringPtr(pushservicev1.Apns_InterruptionLevel_Passive)
+			apnsModel.CriticalSound = &pushservicev1.ApnsCriticalSound{
+				Critical: core.Int64Ptr(int64(1)),
+				Name:     core.StringPtr("alarm.caf"),
+			}
+			Expect(apnsModel.Validate()).ToNot(BeNil())
+		})
+		It(`Rejects background pushes with visible content or high priority`, func() {
+			invalid := []*pushservicev1.Apns{
+				{Title: core.StringPtr("testString")},
+				{Subtitle: core.StringPtr("testString")},
+				{Sound: core.StringPtr("default")},
+				{Badge: core.Int64Ptr(int64(1))},
+				{MutableContent: core.BoolPtr(true)},
+				{InterruptionLevel: core.StringPtr(pushservicev1.Apns_InterruptionLevel_Active)},
+				{ApnsPriority: core.Int64Ptr(int64(10))},
+			}
+			for _, apnsModel := range invalid {
+				apnsModel.ApnsPushType = core.StringPtr(pushservicev1.Apns_ApnsPushType_Background)
+				Expect(apnsModel.Validate()).ToNot(BeNil())
+			}
+		})
+	})
+	Describe(`ChromeWeb.Validate() and FirefoxWeb.Validate()`, func() {
+		actionModel := func(action string) pushservicev1.WebPushAction {
+			return pushservicev1.WebPushAction{Action: core.StringPtr(action), Title: core.StringPtr(action)}
+		}
+		It(`Accepts fully populated web push settings`, func() {
+			chromeWebModel := new(pushservicev1.ChromeWeb)
+			chromeWebModel.Title = core.StringPtr("testString")
+			chromeWebModel.Actions = []pushservicev1.WebPushAction{actionModel("open"), actionModel("dismiss")}
+			chromeWebModel.BadgeURL = core.StringPtr("testString")
+			chromeWebModel.ImageURL = core.StringPtr("testString")
+			chromeWebModel.Body = core.StringPtr("testString")
+			chromeWebModel.RequireInteraction = core.BoolPtr(true)
+			chromeWebModel.Vibrate = []int64{200, 100, 200}
+			chromeWebModel.Tag = core.StringPtr("testString")
+			chromeWebModel.Renotify = core.BoolPtr(true)
+			chromeWebModel.Urgency = core.StringPtr(pushservicev1.ChromeWeb_Urgency_High)
+			chromeWebModel.Topic = core.StringPtr("order_status-42")
+			Expect(chromeWebModel.Validate()).To(BeNil())
+
+			firefoxWebModel := new(pushservicev1.FirefoxWeb)
+			firefoxWebModel.Actions = []pushservicev1.WebPushAction{actionModel("open")}
+			firefoxWebModel.Silent = core.BoolPtr(true)
+			firefoxWebModel.Urgency = core.StringPtr(pushservicev1.FirefoxWeb_Urgency_VeryLow)
+			Expect(firefoxWebModel.Validate()).To(BeNil())
+		})
+		It(`Rejects more than MaxWebPushActions actions`, func() {
+			actions := []pushservicev1.WebPushAction{}
+			for i := 0; i <= pushservicev1.MaxWebPushActions; i++ {
+				actions = append(actions, actionModel(fmt.Sprintf("action%d", i)))
+			}
+			Expect((&pushservicev1.ChromeWeb{Actions: actions}).Validate()).ToNot(BeNil())
+			Expect((&pushservicev1.FirefoxWeb{Actions: actions}).Validate()).ToNot(BeNil())
+		})
+		It(`Rejects invalid combinations and values`, func() {
+			invalid := []*pushservicev1.ChromeWeb{
+				{Actions: []pushservicev1.WebPushAction{{Action: core.StringPtr("open")}}},
+				{Renotify: core.BoolPtr(true)},
+				{Silent: core.BoolPtr(true), Vibrate: []int64{100}},
+				{Vibrate: []int64{-1}},
+				{Urgency: core.StringPtr("urgent")},
+				{Topic: core.StringPtr("")},
+				{Topic: core.StringPtr("a-topic-that-is-longer-than-32-characters")},
+				{Topic: core.StringPtr("not/url+safe")},
+			}
+			for _, chromeWebModel := range invalid {
+				Expect(chromeWebModel.Validate()).ToNot(BeNil())
+			}
+		})
+		It(`Checks the urgency against the values of each platform`, func() {
+			for _, urgency := range []string{pushservicev1.ChromeWeb_Urgency_VeryLow, pushservicev1.ChromeWeb_Urgency_Low,
+				pushservicev1.ChromeWeb_Urgency_Normal, pushservicev1.ChromeWeb_Urgency_High} {
+				Expect((&pushservicev1.ChromeWeb{Urgency: core.StringPtr(urgency)}).Validate()).To(BeNil())
+			}
+			for _, urgency := range []string{pushservicev1.FirefoxWeb_Urgency_VeryLow, pushservicev1.FirefoxWeb_Urgency_Low,
+				pushservicev1.FirefoxWeb_Urgency_Normal, pushservicev1.FirefoxWeb_Urgency_High} {
+				Expect((&pushservicev1.FirefoxWeb{Urgency: core.StringPtr(urgency)}).Validate()).To(BeNil())
+			}
+			err := (&pushservicev1.FirefoxWeb{Urgency: core.StringPtr("urgent")}).Validate()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("firefoxWeb: invalid urgency 'urgent'"))
+		})
+	})
+	Describe(`Settings.Validate()`, func() {
+		It(`Accepts nil settings`, func() {
+			var settingsModel *pushservicev1.Settings
+			Expect(settingsModel.Validate()).To(BeNil())
+		})
+		It(`Reports an invalid Apns section`, func() {
+			settingsModel := new(pushservicev1.Settings)
+			settingsModel.Apns = &pushservicev1.Apns{RelevanceScore: core.Float64Ptr(2)}
+			Expect(settingsModel.Validate()).ToNot(BeNil())
+		})
+		It(`Reports invalid web push sections`, func() {
+			settingsModel := new(pushservicev1.Settings)
+			settingsModel.ChromeWeb = &pushservicev1.ChromeWeb{Urgency: core.StringPtr("urgent")}
+			Expect(settingsModel.Validate()).ToNot(BeNil())
+
+			settingsModel = new(pushservicev1.Settings)
+			settingsModel.FirefoxWeb = &pushservicev1.FirefoxWeb{Renotify: core.BoolPtr(true)}
+			Expect(settingsModel.Validate()).ToNot(BeNil())
+		})
+	})
+	Describe(`UnmarshalApns(m map[string]json.RawMessage, result interface{})`, func() {
+		It(`Unmarshals the iOS 15 fields`, func() {
+			var raw map[string]json.RawMessage
+			err := json.Unmarshal([]byte(`{"interruptionLevel": "critical", "relevanceScore": 0.5, "targetContentId": "TargetContentID", "mutableContent": true, "criticalSound": {"critical": 1, "name": "Name", "volume": 0.8}, "apnsPushType": "alert", "apnsExpiration": 1700000000, "apnsPriority": 10}`), &raw)
+			Expect(err).To(BeNil())
+
+			var result *pushservicev1.Apns
+			err = pushservicev1.UnmarshalApns(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result.InterruptionLevel).To(Equal(core.StringPtr("critical")))
+			Expect(result.RelevanceScore).To(Equal(core.Float64Ptr(0.5)))
+			Expect(result.TargetContentID).To(Equal(core.StringPtr("TargetContentID")))
+			Expect(result.MutableContent).To(Equal(core.BoolPtr(true)))
+			Expect(result.CriticalSound).ToNot(BeNil())
+			Expect(result.CriticalSound.Critical).To(Equal(core.Int64Ptr(int64(1))))
+			Expect(result.CriticalSound.Name).To(Equal(core.StringPtr("Name")))
+			Expect(result.CriticalSound.Volume).To(Equal(core.Float64Ptr(0.8)))
+			Expect(result.ApnsPushType).To(Equal(core.StringPtr("alert")))
+			Expect(result.ApnsExpiration).To(Equal(core.Int64Ptr(int64(1700000000))))
+			Expect(result.ApnsPriority).To(Equal(core.Int64Ptr(int64(10))))
+		})
+	})
+	Describe(`SendMessage(sendMessageOptions *SendMessageOptions) - Settings validation`, func() {
+		var testServer *httptest.Server
+		var requestCount int
+		BeforeEach(func() {
+			requestCount = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				requestCount++
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(202)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke SendMessage with invalid Apns settings without sending a request`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			sendMessageOptionsModel := pushServiceService.NewSendMessageOptions("testString", &pushservicev1.Message{Alert: core.StringPtr("testString")})
+			sendMessageOptionsModel.SetSettings(&pushservicev1.Settings{
+				Apns: &pushservicev1.Apns{
+					ApnsPushType: core.StringPtr(pushservicev1.Apns_ApnsPushType_Background),
+					Title:        core.StringPtr("testString"),
+				},
+			})
+			result, response, operationErr := pushServiceService.SendMessage(sendMessageOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(requestCount).To(Equal(0))
+		})
+		It(`Invoke SendMessagesInBulk with invalid Apns settings without sending a request`, func() {
+			pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			body := []pushservicev1.SendMessageBody{
+				{Message: &pushservicev1.Message{Alert: core.StringPtr("testString")}},
+				{
+					Message:  &pushservicev1.Message{Alert: core.StringPtr("testString")},
+					Settings: &pushservicev1.Settings{Apns: &pushservicev1.Apns{ApnsPriority: core.Int64Ptr(int64(3))}},
+				},
+			}
+			sendMessagesInBulkOptionsModel := pushServiceService.NewSendMessagesInBulkOptions("testString", body)
+			result, response, operationErr := pushServiceService.SendMessagesInBulk(sendMessagesInBulkOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(requestCount).To(Equal(0))
+		})
+	})
+})
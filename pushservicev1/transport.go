@@ -0,0 +1,216 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// NoRedirects is a redirect policy, for PushServiceV1Options.CheckRedirect, which refuses every redirect.
+func NoRedirects(req *http.Request, via []*http.Request) error {
+	return fmt.Errorf("redirect to %s refused", req.URL.Host)
+}
+
+// hasTransportOptions reports whether the options change the HTTP client of the service.
+func (options *PushServiceV1Options) hasTransportOptions() bool {
+	return options.HTTPClient != nil || options.Transport != nil || options.hasTLSOptions() ||
+		options.CheckRedirect != nil || options.MaxResponseBodySize > 0
+}
+
+// hasTLSOptions reports whether the options change the proxy or the TLS configuration of the transport.
+func (options *PushServiceV1Options) hasTLSOptions() bool {
+	return options.ProxyURL != "" || len(options.ClientCertificates) > 0 || options.MinTLSVersion != 0 ||
+		len(options.PinnedPublicKeys) > 0
+}
+
+// configureHTTPClient sets the HTTP client described by the transport options on the service. When the options set
+// none, the go-sdk-core default client is left in place.
+//
+// Note that BaseService.DisableSSLVerification replaces the client, discarding these options.
+func (options *PushServiceV1Options) configureHTTPClient(baseService *core.BaseService) error {
+	if !options.hasTransportOptions() {
+		return nil
+	}
+
+	var client http.Client
+	if options.HTTPClient != nil {
+		client = *options.HTTPClient
+	} else {
+		client = *core.DefaultHTTPClient()
+	}
+	if options.Transport != nil {
+		client.Transport = options.Transport
+	}
+	if client.Transport == nil {
+		client.Transport = http.DefaultTransport
+	}
+
+	if options.hasTLSOptions() {
+		transport, ok := client.Transport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("ProxyURL, ClientCertificates, MinTLSVersion and PinnedPublicKeys require an *http.Transport, got %T", client.Transport)
+		}
+		transport, err := options.configureTransport(transport)
+		if err != nil {
+			return err
+		}
+		client.Transport = transport
+	}
+	if options.MaxResponseBodySize > 0 {
+		client.Transport = &limitedBodyTransport{transport: client.Transport, maxSize: options.MaxResponseBodySize}
+	}
+	if options.CheckRedirect != nil {
+		client.CheckRedirect = options.CheckRedirect
+	}
+
+	baseService.SetHTTPClient(&client)
+	return nil
+}
+
+// configureTransport returns a copy of transport with the proxy and TLS options applied.
+func (options *PushServiceV1Options) configureTransport(transport *http.Transport) (*http.Transport, error) {
+	transport = transport.Clone()
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid ProxyURL '%s'", options.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var tlsConfig *tls.Config
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	} else {
+		tlsConfig = new(tls.Config)
+	}
+	if len(options.ClientCertificates) > 0 {
+		tlsConfig.Certificates = append(tlsConfig.Certificates, options.ClientCertificates...)
+	}
+	if options.MinTLSVersion != 0 {
+		tlsConfig.MinVersion = options.MinTLSVersion
+	}
+	if len(options.PinnedPublicKeys) > 0 {
+		verifyPins, err := verifyPinnedPublicKeys(options.PinnedPublicKeys)
+		if err != nil {
+			return nil, err
+		}
+		verifyConnection := tlsConfig.VerifyConnection
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if verifyConnection != nil {
+				if err := verifyConnection(state); err != nil {
+					return err
+				}
+			}
+			return verifyPins(state)
+		}
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// verifyPinnedPublicKeys returns a tls.Config.VerifyConnection function which accepts a connection only if one of the
+// certificates presented by the server has one of the pinned public keys. It runs after the usual verification of the
+// certificates and, unlike VerifyPeerCertificate, on resumed sessions too.
+func verifyPinnedPublicKeys(pins []string) (func(state tls.ConnectionState) error, error) {
+	hashes := make([][]byte, 0, len(pins))
+	for _, pin := range pins {
+		hash, err := base64.StdEncoding.DecodeString(pin)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid pinned public key '%s': expected the base64 encoding of a SHA-256 hash", pin)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return func(state tls.ConnectionState) error {
+		for _, certificate := range state.PeerCertificates {
+			hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+			for _, pinned := range hashes {
+				if bytes.Equal(hash[:], pinned) {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("the service certificate does not match any pinned public key")
+	}, nil
+}
+
+// PublicKeyPin returns the pin of the public key of a certificate, for PushServiceV1Options.PinnedPublicKeys.
+func PublicKeyPin(certificate *x509.Certificate) string {
+	hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// limitedBodyTransport fails responses whose body is larger than maxSize.
+type limitedBodyTransport struct {
+	transport http.RoundTripper
+	maxSize   int64
+}
+
+func (limited *limitedBodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := limited.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.ContentLength > limited.maxSize {
+		res.Body.Close()
+		return nil, limited.error()
+	}
+	res.Body = &limitedBody{body: res.Body, remaining: limited.maxSize, err: limited.error()}
+	return res, nil
+}
+
+func (limited *limitedBodyTransport) error() error {
+	return fmt.Errorf("the response body exceeds the maximum size of %d bytes", limited.maxSize)
+}
+
+// limitedBody reads a response body, failing with err once more than remaining bytes are read.
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+	err       error
+}
+
+func (limited *limitedBody) Read(p []byte) (int, error) {
+	if limited.remaining < 0 {
+		return 0, limited.err
+	}
+	// Read one byte more than allowed, to tell a body of exactly the maximum size from a larger one.
+	if int64(len(p)) > limited.remaining+1 {
+		p = p[:limited.remaining+1]
+	}
+	n, err := limited.body.Read(p)
+	limited.remaining -= int64(n)
+	if limited.remaining < 0 {
+		return n + int(limited.remaining), limited.err
+	}
+	return n, err
+}
+
+func (limited *limitedBody) Close() error {
+	return limited.body.Close()
+}
@@ -0,0 +1,275 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pushservicev1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var _ = Describe(`Transport options`, func() {
+	var requests []*http.Request
+	handler := http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		requests = append(requests, req)
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.Path {
+		case "/redirect/apps/testString/settings":
+			http.Redirect(res, req, "/apps/testString/settings", http.StatusFound)
+		case "/large/apps/testString/settings":
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"apnsConf": "%s"}`, strings.Repeat("x", 4096))
+		case "/chunked/apps/testString/settings":
+			// Flushing sends the body without a Content-Length.
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"apnsConf": "`)
+			res.(http.Flusher).Flush()
+			fmt.Fprintf(res, `%s"}`, strings.Repeat("x", 4096))
+		default:
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"apnsConf": "apnsConf"}`)
+		}
+	})
+	BeforeEach(func() {
+		requests = nil
+	})
+	newService := func(options *pushservicev1.PushServiceV1Options) *pushservicev1.PushServiceV1 {
+		options.Authenticator = &core.NoAuthAuthenticator{}
+		pushServiceService, serviceErr := pushservicev1.NewPushServiceV1(options)
+		Expect(serviceErr).To(BeNil())
+		return pushServiceService
+	}
+	getSettings := func(pushServiceService *pushservicev1.PushServiceV1) error {
+		_, _, err := pushServiceService.GetSettings(pushServiceService.NewGetSettingsOptions("testString"))
+		return err
+	}
+	It(`Keep the default HTTP client without transport options`, func() {
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{})
+		defaultTransport := core.DefaultHTTPClient().Transport
+		Expect(pushServiceService.Service.Client.Transport).To(BeAssignableToTypeOf(defaultTransport))
+		Expect(pushServiceService.Service.Client.CheckRedirect).To(BeNil())
+	})
+	It(`Use a custom transport`, func() {
+		testServer := httptest.NewServer(handler)
+		defer testServer.Close()
+		roundTrips := 0
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL: testServer.URL,
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				roundTrips++
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+		Expect(roundTrips).To(Equal(1))
+
+		// The TLS options cannot be applied to it.
+		_, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Transport:     roundTripperFunc(http.DefaultTransport.RoundTrip),
+			MinTLSVersion: tls.VersionTLS12,
+		})
+		Expect(serviceErr).ToNot(BeNil())
+	})
+	It(`Send requests through a proxy`, func() {
+		proxy := httptest.NewServer(handler)
+		defer proxy.Close()
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:      "http://push.example.com",
+			ProxyURL: proxy.URL,
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Host).To(Equal("push.example.com"))
+
+		_, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			ProxyURL:      "proxy.example.com",
+		})
+		Expect(serviceErr).ToNot(BeNil())
+	})
+	It(`Enforce the minimum TLS version`, func() {
+		testServer := httptest.NewUnstartedServer(handler)
+		testServer.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+		testServer.StartTLS()
+		defer testServer.Close()
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			HTTPClient:    testServer.Client(),
+			MinTLSVersion: tls.VersionTLS12,
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+
+		pushServiceService = newService(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL,
+			HTTPClient:    testServer.Client(),
+			MinTLSVersion: tls.VersionTLS13,
+		})
+		Expect(getSettings(pushServiceService)).ToNot(BeNil())
+		Expect(testServer.Client().Transport.(*http.Transport).TLSClientConfig.MinVersion).To(BeZero())
+	})
+	It(`Present client certificates`, func() {
+		testServer := httptest.NewUnstartedServer(handler)
+		testServer.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		testServer.StartTLS()
+		defer testServer.Close()
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:        testServer.URL,
+			HTTPClient: testServer.Client(),
+		})
+		Expect(getSettings(pushServiceService)).ToNot(BeNil())
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "client"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).To(BeNil())
+
+		pushServiceService = newService(&pushservicev1.PushServiceV1Options{
+			URL:                testServer.URL,
+			HTTPClient:         testServer.Client(),
+			ClientCertificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+		Expect(requests[len(requests)-1].TLS.PeerCertificates[0].Subject.CommonName).To(Equal("client"))
+	})
+	It(`Pin the public key of the service`, func() {
+		testServer := httptest.NewTLSServer(handler)
+		defer testServer.Close()
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:              testServer.URL,
+			HTTPClient:       testServer.Client(),
+			PinnedPublicKeys: []string{pushservicev1.PublicKeyPin(testServer.Certificate())},
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+
+		pushServiceService = newService(&pushservicev1.PushServiceV1Options{
+			URL:              testServer.URL,
+			HTTPClient:       testServer.Client(),
+			PinnedPublicKeys: []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		})
+		err := getSettings(pushServiceService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("pinned public key"))
+
+		_, serviceErr := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+			Authenticator:    &core.NoAuthAuthenticator{},
+			PinnedPublicKeys: []string{"not a pin"},
+		})
+		Expect(serviceErr).ToNot(BeNil())
+	})
+	It(`Pin the public key of the service on resumed sessions`, func() {
+		var resumed []bool
+		testServer := httptest.NewUnstartedServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			resumed = append(resumed, req.TLS.DidResume)
+			handler.ServeHTTP(res, req)
+		}))
+		testServer.StartTLS()
+		defer testServer.Close()
+
+		// Every request opens a new connection, which resumes the session of the previous one from the shared cache.
+		transport := testServer.Client().Transport.(*http.Transport).Clone()
+		transport.DisableKeepAlives = true
+		transport.TLSClientConfig.ClientSessionCache = tls.NewLRUClientSessionCache(8)
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:              testServer.URL,
+			Transport:        transport,
+			PinnedPublicKeys: []string{pushservicev1.PublicKeyPin(testServer.Certificate())},
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+		Expect(getSettings(pushServiceService)).To(BeNil())
+		Expect(resumed).To(Equal([]bool{false, true}))
+
+		pushServiceService = newService(&pushservicev1.PushServiceV1Options{
+			URL:              testServer.URL,
+			Transport:        transport,
+			PinnedPublicKeys: []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		})
+		err := getSettings(pushServiceService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("pinned public key"))
+		Expect(resumed).To(HaveLen(2))
+	})
+	It(`Apply the redirect policy`, func() {
+		testServer := httptest.NewServer(handler)
+		defer testServer.Close()
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{URL: testServer.URL + "/redirect"})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+
+		pushServiceService = newService(&pushservicev1.PushServiceV1Options{
+			URL:           testServer.URL + "/redirect",
+			CheckRedirect: pushservicev1.NoRedirects,
+		})
+		err := getSettings(pushServiceService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("redirect"))
+	})
+	It(`Limit the size of response bodies`, func() {
+		testServer := httptest.NewServer(handler)
+		defer testServer.Close()
+
+		pushServiceService := newService(&pushservicev1.PushServiceV1Options{
+			URL:                 testServer.URL,
+			MaxResponseBodySize: 1024,
+		})
+		Expect(getSettings(pushServiceService)).To(BeNil())
+
+		Expect(pushServiceService.SetServiceURL(testServer.URL + "/large")).To(BeNil())
+		err := getSettings(pushServiceService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("maximum size"))
+
+		Expect(pushServiceService.SetServiceURL(testServer.URL + "/chunked")).To(BeNil())
+		err = getSettings(pushServiceService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("maximum size"))
+	})
+})
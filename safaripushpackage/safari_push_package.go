@@ -0,0 +1,496 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package safaripushpackage builds and verifies Safari push packages, the signed zip files Safari downloads when a user
+// subscribes to Safari Push Notifications. It takes the same inputs as pushservicev1.SaveSafariWebConfOptions, so a
+// Safari configuration can be checked offline before it is uploaded to the push service.
+package safaripushpackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"go.mozilla.org/pkcs7"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// The names of the files of a push package.
+const (
+	WebsiteFileName   = "website.json"
+	ManifestFileName  = "manifest.json"
+	SignatureFileName = "signature"
+
+	Icon16x16     = "icon.iconset/icon_16x16.png"
+	Icon16x162x   = "icon.iconset/icon_16x16@2x.png"
+	Icon32x32     = "icon.iconset/icon_32x32.png"
+	Icon32x322x   = "icon.iconset/icon_32x32@2x.png"
+	Icon128x128   = "icon.iconset/icon_128x128.png"
+	Icon128x1282x = "icon.iconset/icon_128x128@2x.png"
+)
+
+// iconSizes maps the name of each icon of the iconset to its width and height in pixels.
+var iconSizes = map[string]int{
+	Icon16x16:     16,
+	Icon16x162x:   32,
+	Icon32x32:     32,
+	Icon32x322x:   64,
+	Icon128x128:   128,
+	Icon128x1282x: 256,
+}
+
+// minAuthenticationTokenLength is the minimum length Safari accepts for website.json authenticationToken.
+const minAuthenticationTokenLength = 16
+
+// oidUserID is the subject attribute in which Apple stores the Website Push ID of the certificate.
+var oidUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+
+// Options : The inputs of a push package.
+type Options struct {
+	// The website name. This is the heading used in Notification Center.
+	WebsiteName string
+
+	// Unique reverse-domain string for your Website Push ID, such as web.com.example.domain.
+	WebsitePushID string
+
+	// The websites allowed to request permission from the user, such as https://example.com.
+	AllowedDomains []string
+
+	// The URL to go to when the notification is clicked, with %@ placeholders for the urlArgs of the notification.
+	UrlFormatString string
+
+	// The string Safari sends back to WebServiceURL to identify the user. Must be at least 16 characters long; a random
+	// token is generated when it is empty.
+	AuthenticationToken string
+
+	// The HTTPS URL of the web service Safari contacts to register devices and log errors.
+	WebServiceURL string
+
+	// The PNG icons of the iconset, keyed by their file names (Icon16x16, Icon16x162x, ...).
+	Icons map[string][]byte
+
+	// The Website Push ID certificate (p12 format) used to sign the package.
+	Certificate []byte
+
+	// Password for the Website Push ID certificate.
+	Password string
+
+	// Additional certificates to include in the signature, such as the Apple WWDR intermediate certificate. The
+	// intermediate certificates stored in the p12 file are included too.
+	Intermediates []*x509.Certificate
+}
+
+// NewOptionsFromSaveSafariWebConfOptions returns the options of the push package described by saveSafariWebConfOptions.
+// The certificate and icon readers are read to the end and closed. WebServiceURL must be set on the result before the
+// package is built.
+func NewOptionsFromSaveSafariWebConfOptions(saveSafariWebConfOptions *pushservicev1.SaveSafariWebConfOptions) (options *Options, err error) {
+	if saveSafariWebConfOptions == nil {
+		return nil, fmt.Errorf("saveSafariWebConfOptions cannot be nil")
+	}
+	options = &Options{
+		WebsiteName:     stringValue(saveSafariWebConfOptions.WebsiteName),
+		WebsitePushID:   stringValue(saveSafariWebConfOptions.WebsitePushID),
+		UrlFormatString: stringValue(saveSafariWebConfOptions.UrlFormatString),
+		Password:        stringValue(saveSafariWebConfOptions.Password),
+		Icons:           make(map[string][]byte),
+	}
+	if saveSafariWebConfOptions.WebSiteURL != nil {
+		options.AllowedDomains = []string{*saveSafariWebConfOptions.WebSiteURL}
+	}
+
+	options.Certificate, err = readAndClose(saveSafariWebConfOptions.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the certificate: %s", err.Error())
+	}
+	icons := map[string]io.ReadCloser{
+		Icon16x16:     saveSafariWebConfOptions.Icon16x16,
+		Icon16x162x:   saveSafariWebConfOptions.Icon16x162x,
+		Icon32x32:     saveSafariWebConfOptions.Icon32x32,
+		Icon32x322x:   saveSafariWebConfOptions.Icon32x322x,
+		Icon128x128:   saveSafariWebConfOptions.Icon128x128,
+		Icon128x1282x: saveSafariWebConfOptions.Icon128x1282x,
+	}
+	for name, icon := range icons {
+		if icon == nil {
+			continue
+		}
+		options.Icons[name], err = readAndClose(icon)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %s", name, err.Error())
+		}
+	}
+	return options, nil
+}
+
+// Validate checks the options against the requirements Safari places on a push package.
+func (options *Options) Validate() error {
+	if options.WebsiteName == "" {
+		return fmt.Errorf("websiteName is required")
+	}
+	if !strings.HasPrefix(options.WebsitePushID, "web.") {
+		return fmt.Errorf("websitePushID must start with 'web.', got '%s'", options.WebsitePushID)
+	}
+	if len(options.AllowedDomains) == 0 {
+		return fmt.Errorf("at least one allowed domain is required")
+	}
+	for _, domain := range options.AllowedDomains {
+		if err := validateURL("allowedDomains", domain, "http", "https"); err != nil {
+			return err
+		}
+	}
+	// The %@ placeholders are not valid URL escapes, so the URL is checked with sample arguments in their place.
+	sampleURL := strings.Replace(options.UrlFormatString, "%@", "arg", -1)
+	if err := validateURL("urlFormatString", sampleURL, "http", "https"); err != nil {
+		return fmt.Errorf("urlFormatString must be an absolute http or https URL, got '%s'", options.UrlFormatString)
+	}
+	if options.AuthenticationToken != "" && len(options.AuthenticationToken) < minAuthenticationTokenLength {
+		return fmt.Errorf("authenticationToken must be at least %d characters long", minAuthenticationTokenLength)
+	}
+	if err := validateURL("webServiceURL", options.WebServiceURL, "https"); err != nil {
+		return err
+	}
+	for _, name := range iconNames() {
+		if err := validateIcon(name, options.Icons[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Build returns the push package described by options as a zip file.
+func Build(options *Options) ([]byte, error) {
+	if options == nil {
+		return nil, fmt.Errorf("options cannot be nil")
+	}
+	err := options.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, privateKey, intermediates, err := decodeCertificate(options.Certificate, options.Password)
+	if err != nil {
+		return nil, err
+	}
+	intermediates = appendCertificates(append([]*x509.Certificate(nil), options.Intermediates...), intermediates...)
+	if pushID := websitePushIDOf(certificate); pushID != "" && pushID != options.WebsitePushID {
+		return nil, fmt.Errorf("the certificate is for Website Push ID '%s', not '%s'", pushID, options.WebsitePushID)
+	}
+
+	authenticationToken := options.AuthenticationToken
+	if authenticationToken == "" {
+		authenticationToken, err = newAuthenticationToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+	website, err := json.MarshalIndent(&Website{
+		WebsiteName:         options.WebsiteName,
+		WebsitePushID:       options.WebsitePushID,
+		AllowedDomains:      options.AllowedDomains,
+		UrlFormatString:     options.UrlFormatString,
+		AuthenticationToken: authenticationToken,
+		WebServiceURL:       options.WebServiceURL,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{WebsiteFileName: website}
+	for _, name := range iconNames() {
+		files[name] = options.Icons[name]
+	}
+	manifest, err := newManifest(files)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := sign(manifest, certificate, privateKey, intermediates)
+	if err != nil {
+		return nil, err
+	}
+	files[ManifestFileName] = manifest
+	files[SignatureFileName] = signature
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, name := range sortedNames(files) {
+		writer, err := archive.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = writer.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err = archive.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Website : The contents of website.json.
+type Website struct {
+	WebsiteName         string   `json:"websiteName"`
+	WebsitePushID       string   `json:"websitePushID"`
+	AllowedDomains      []string `json:"allowedDomains"`
+	UrlFormatString     string   `json:"urlFormatString"`
+	AuthenticationToken string   `json:"authenticationToken"`
+	WebServiceURL       string   `json:"webServiceURL"`
+}
+
+// Package : A push package which passed Verify.
+type Package struct {
+	// The contents of website.json.
+	Website *Website
+
+	// The certificate which signed the package.
+	Signer *x509.Certificate
+
+	// The contents of every file of the package, keyed by file name.
+	Files map[string][]byte
+}
+
+// Verify checks that a push package contains website.json and every icon, that each file matches its hash in
+// manifest.json and that the signature over manifest.json is valid. When roots is not nil, the certificate chain of the
+// signature must also lead to one of its certificates.
+func Verify(packageZip []byte, roots *x509.CertPool) (*Package, error) {
+	archive, err := zip.NewReader(bytes.NewReader(packageZip), int64(len(packageZip)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the push package: %s", err.Error())
+	}
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		files[file.Name], err = readAndClose(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range append([]string{WebsiteFileName, ManifestFileName, SignatureFileName}, iconNames()...) {
+		if _, ok := files[name]; !ok {
+			return nil, fmt.Errorf("the push package has no %s", name)
+		}
+	}
+
+	var manifest map[string]manifestEntry
+	if err = json.Unmarshal(files[ManifestFileName], &manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %s", ManifestFileName, err.Error())
+	}
+	for name, content := range files {
+		if name == ManifestFileName || name == SignatureFileName {
+			continue
+		}
+		entry, ok := manifest[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is not listed in %s", name, ManifestFileName)
+		}
+		if entry.HashType != manifestHashType || entry.HashValue != sha512Hex(content) {
+			return nil, fmt.Errorf("%s does not match its hash in %s", name, ManifestFileName)
+		}
+	}
+	for name := range manifest {
+		if _, ok := files[name]; !ok {
+			return nil, fmt.Errorf("%s lists %s, which is not in the push package", ManifestFileName, name)
+		}
+	}
+
+	signature, err := pkcs7.Parse(files[SignatureFileName])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the signature: %s", err.Error())
+	}
+	signature.Content = files[ManifestFileName]
+	if roots != nil {
+		err = signature.VerifyWithChain(roots)
+	} else {
+		err = signature.Verify()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the signature does not match %s: %s", ManifestFileName, err.Error())
+	}
+
+	website := new(Website)
+	if err = json.Unmarshal(files[WebsiteFileName], website); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %s", WebsiteFileName, err.Error())
+	}
+	return &Package{
+		Website: website,
+		Signer:  signature.GetOnlySigner(),
+		Files:   files,
+	}, nil
+}
+
+// manifestHashType is the hash algorithm of the manifest entries.
+const manifestHashType = "sha512"
+
+// manifestEntry is the hash of one file in manifest.json.
+type manifestEntry struct {
+	HashType  string `json:"hashType"`
+	HashValue string `json:"hashValue"`
+}
+
+func newManifest(files map[string][]byte) ([]byte, error) {
+	manifest := make(map[string]manifestEntry, len(files))
+	for name, content := range files {
+		manifest[name] = manifestEntry{
+			HashType:  manifestHashType,
+			HashValue: sha512Hex(content),
+		}
+	}
+	return json.MarshalIndent(manifest, "", "  ")
+}
+
+// sign returns the detached PKCS#7 signature of manifest.
+func sign(manifest []byte, certificate *x509.Certificate, privateKey crypto.PrivateKey, intermediates []*x509.Certificate) ([]byte, error) {
+	signedData, err := pkcs7.NewSignedData(manifest)
+	if err != nil {
+		return nil, err
+	}
+	signedData.SetDigestAlgorithm(pkcs7.OIDDigestAlgorithmSHA256)
+	err = signedData.AddSignerChain(certificate, privateKey, intermediates, pkcs7.SignerInfoConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign %s: %s", ManifestFileName, err.Error())
+	}
+	signedData.Detach()
+	return signedData.Finish()
+}
+
+// decodeCertificate returns the certificate, private key and intermediate certificates of a p12 file.
+func decodeCertificate(p12 []byte, password string) (certificate *x509.Certificate, privateKey crypto.PrivateKey, intermediates []*x509.Certificate, err error) {
+	privateKey, certificate, intermediates, err = pkcs12.DecodeChain(p12, password)
+	if err == pkcs12.ErrIncorrectPassword {
+		return nil, nil, nil, fmt.Errorf("incorrect password for the certificate")
+	}
+	if _, ok := err.(pkcs12.NotImplementedError); ok {
+		return nil, nil, nil, fmt.Errorf("the format of the certificate file is not supported: %s", err.Error())
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to decode the certificate: %s", err.Error())
+	}
+	return
+}
+
+// appendCertificates appends to certificates those of others it does not hold yet.
+func appendCertificates(certificates []*x509.Certificate, others ...*x509.Certificate) []*x509.Certificate {
+	for _, other := range others {
+		found := false
+		for _, certificate := range certificates {
+			if certificate.Equal(other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			certificates = append(certificates, other)
+		}
+	}
+	return certificates
+}
+
+// websitePushIDOf returns the Website Push ID stored in the subject of certificate, if any.
+func websitePushIDOf(certificate *x509.Certificate) string {
+	for _, name := range certificate.Subject.Names {
+		if pushID, ok := name.Value.(string); ok && name.Type.Equal(oidUserID) {
+			return pushID
+		}
+	}
+	return ""
+}
+
+func validateURL(field string, value string, schemes ...string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%s must be an absolute URL, got '%s'", field, value)
+	}
+	for _, scheme := range schemes {
+		if parsed.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must use the %s scheme, got '%s'", field, strings.Join(schemes, " or "), value)
+}
+
+// validateIcon checks that icon is a PNG image of the size its name requires.
+func validateIcon(name string, icon []byte) error {
+	if len(icon) == 0 {
+		return fmt.Errorf("%s is required", name)
+	}
+	if err := pushservicev1.ValidateSafariIcon(icon, iconSizes[name]); err != nil {
+		return fmt.Errorf("%s: %s", name, err.Error())
+	}
+	return nil
+}
+
+func newAuthenticationToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+func iconNames() []string {
+	names := make([]string, 0, len(iconSizes))
+	for name := range iconSizes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sha512Hex(content []byte) string {
+	sum := sha512.Sum512(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func readAndClose(reader io.ReadCloser) ([]byte, error) {
+	if reader == nil {
+		return nil, nil
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,293 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package safaripushpackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// The fixture holds a self-signed Website Push ID certificate for web.com.example, protected with the password "secret".
+func readCertificate(t *testing.T) []byte {
+	p12, err := ioutil.ReadFile(filepath.Join("testdata", "website_push_id.p12"))
+	require.Nil(t, err)
+	return p12
+}
+
+func newIcon(t *testing.T, size int) []byte {
+	var buffer bytes.Buffer
+	require.Nil(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, size, size))))
+	return buffer.Bytes()
+}
+
+func newOptions(t *testing.T) *Options {
+	icons := make(map[string][]byte)
+	for name, size := range iconSizes {
+		icons[name] = newIcon(t, size)
+	}
+	return &Options{
+		WebsiteName:         "Example",
+		WebsitePushID:       "web.com.example",
+		AllowedDomains:      []string{"https://example.com"},
+		UrlFormatString:     "https://example.com/%@",
+		AuthenticationToken: "19f8d7a6e9fb8a7f6d9330dabe",
+		WebServiceURL:       "https://push.example.com",
+		Icons:               icons,
+		Certificate:         readCertificate(t),
+		Password:            "secret",
+	}
+}
+
+func TestBuildAndVerify(t *testing.T) {
+	packageZip, err := Build(newOptions(t))
+	require.Nil(t, err)
+
+	pushPackage, err := Verify(packageZip, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "web.com.example", pushPackage.Website.WebsitePushID)
+	assert.Equal(t, []string{"https://example.com"}, pushPackage.Website.AllowedDomains)
+	assert.Equal(t, "19f8d7a6e9fb8a7f6d9330dabe", pushPackage.Website.AuthenticationToken)
+	assert.Equal(t, "Website Push ID: web.com.example", pushPackage.Signer.Subject.CommonName)
+	assert.Len(t, pushPackage.Files, 9)
+
+	var manifest map[string]manifestEntry
+	require.Nil(t, json.Unmarshal(pushPackage.Files[ManifestFileName], &manifest))
+	assert.Len(t, manifest, 7)
+	assert.Equal(t, "sha512", manifest[Icon128x1282x].HashType)
+
+	// The self-signed fixture is its own root.
+	roots := x509.NewCertPool()
+	roots.AddCert(pushPackage.Signer)
+	_, err = Verify(packageZip, roots)
+	assert.Nil(t, err)
+	_, err = Verify(packageZip, x509.NewCertPool())
+	assert.NotNil(t, err)
+}
+
+// newChainCertificate returns a Website Push ID certificate for web.com.example issued by an intermediate certificate, in
+// a p12 file encrypted with PBES2 and AES, and the root certificate of the chain.
+func newChainCertificate(t *testing.T, password string) (p12 []byte, root *x509.Certificate) {
+	newCertificate := func(template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.Nil(t, err)
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		require.Nil(t, err)
+		certificate, err := x509.ParseCertificate(der)
+		require.Nil(t, err)
+		return certificate, key
+	}
+	authority := func(serial int64, name string) *x509.Certificate {
+		return &x509.Certificate{SerialNumber: big.NewInt(serial), Subject: pkix.Name{CommonName: name},
+			IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}
+	}
+	root, rootKey := newCertificate(authority(1, "Root"), nil, nil)
+	intermediate, intermediateKey := newCertificate(authority(2, "Intermediate"), root, rootKey)
+	leaf, leafKey := newCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject: pkix.Name{
+			CommonName: "Website Push ID: web.com.example",
+			ExtraNames: []pkix.AttributeTypeAndValue{{Type: oidUserID, Value: "web.com.example"}},
+		},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}, intermediate, intermediateKey)
+
+	p12, err := pkcs12.Modern.Encode(leafKey, leaf, []*x509.Certificate{intermediate}, password)
+	require.Nil(t, err)
+	return p12, root
+}
+
+func TestBuildWithCertificateChain(t *testing.T) {
+	options := newOptions(t)
+	var root *x509.Certificate
+	options.Certificate, root = newChainCertificate(t, "secret")
+
+	packageZip, err := Build(options)
+	require.Nil(t, err)
+
+	// The intermediate certificate of the p12 file is included in the signature.
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	pushPackage, err := Verify(packageZip, roots)
+	require.Nil(t, err)
+	assert.Equal(t, "Website Push ID: web.com.example", pushPackage.Signer.Subject.CommonName)
+}
+
+func TestBuildGeneratesAuthenticationToken(t *testing.T) {
+	options := newOptions(t)
+	options.AuthenticationToken = ""
+
+	packageZip, err := Build(options)
+	require.Nil(t, err)
+	pushPackage, err := Verify(packageZip, nil)
+	require.Nil(t, err)
+	assert.True(t, len(pushPackage.Website.AuthenticationToken) >= minAuthenticationTokenLength)
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]func(options *Options){
+		"websitePushID":       func(options *Options) { options.WebsitePushID = "com.example" },
+		"allowedDomains":      func(options *Options) { options.AllowedDomains = nil },
+		"urlFormatString":     func(options *Options) { options.UrlFormatString = "example://%@" },
+		"authenticationToken": func(options *Options) { options.AuthenticationToken = "short" },
+		"webServiceURL":       func(options *Options) { options.WebServiceURL = "http://push.example.com" },
+		"missing icon":        func(options *Options) { delete(options.Icons, Icon32x32) },
+		"icon size":           func(options *Options) { options.Icons[Icon128x1282x] = newIcon(t, 128) },
+		"icon format":         func(options *Options) { options.Icons[Icon16x16] = []byte("not a png") },
+	}
+	for name, modify := range tests {
+		options := newOptions(t)
+		modify(options)
+		_, err := Build(options)
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestBuildRejectsCertificate(t *testing.T) {
+	options := newOptions(t)
+	options.Password = "wrong"
+	_, err := Build(options)
+	assert.NotNil(t, err)
+
+	options = newOptions(t)
+	options.WebsitePushID = "web.com.other"
+	_, err = Build(options)
+	assert.NotNil(t, err)
+}
+
+func TestVerifyRejectsModifiedPackage(t *testing.T) {
+	packageZip, err := Build(newOptions(t))
+	require.Nil(t, err)
+
+	rewrite := func(modify func(files map[string][]byte)) []byte {
+		archive, err := zip.NewReader(bytes.NewReader(packageZip), int64(len(packageZip)))
+		require.Nil(t, err)
+		files := make(map[string][]byte)
+		for _, file := range archive.File {
+			reader, err := file.Open()
+			require.Nil(t, err)
+			files[file.Name], err = readAndClose(reader)
+			require.Nil(t, err)
+		}
+		modify(files)
+
+		var buffer bytes.Buffer
+		writer := zip.NewWriter(&buffer)
+		for name, content := range files {
+			fileWriter, err := writer.Create(name)
+			require.Nil(t, err)
+			_, err = fileWriter.Write(content)
+			require.Nil(t, err)
+		}
+		require.Nil(t, writer.Close())
+		return buffer.Bytes()
+	}
+
+	_, err = Verify(rewrite(func(files map[string][]byte) {
+		files[WebsiteFileName] = []byte(`{"websiteName": "Tampered"}`)
+	}), nil)
+	assert.NotNil(t, err)
+
+	_, err = Verify(rewrite(func(files map[string][]byte) {
+		files[ManifestFileName] = append(files[ManifestFileName], ' ')
+	}), nil)
+	assert.NotNil(t, err)
+
+	_, err = Verify(rewrite(func(files map[string][]byte) {
+		delete(files, Icon16x16)
+	}), nil)
+	assert.NotNil(t, err)
+
+	_, err = Verify([]byte("not a zip"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestNewOptionsFromSaveSafariWebConfOptions(t *testing.T) {
+	pushService, err := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	reader := func(content []byte) *readCloser {
+		return &readCloser{Reader: bytes.NewReader(content)}
+	}
+	icons := make(map[string]*readCloser)
+	for name, size := range iconSizes {
+		icons[name] = reader(newIcon(t, size))
+	}
+	certificate := reader(readCertificate(t))
+	saveSafariWebConfOptions := pushService.NewSaveSafariWebConfOptions("testString", "secret", certificate,
+		"Example", "https://example.com/%@", "web.com.example", "https://example.com").
+		SetIcon16x16(icons[Icon16x16]).
+		SetIcon16x162x(icons[Icon16x162x]).
+		SetIcon32x32(icons[Icon32x32]).
+		SetIcon32x322x(icons[Icon32x322x]).
+		SetIcon128x128(icons[Icon128x128]).
+		SetIcon128x1282x(icons[Icon128x1282x])
+
+	options, err := NewOptionsFromSaveSafariWebConfOptions(saveSafariWebConfOptions)
+	require.Nil(t, err)
+	assert.True(t, certificate.closed)
+	assert.True(t, icons[Icon128x1282x].closed)
+	assert.Equal(t, []string{"https://example.com"}, options.AllowedDomains)
+	assert.Len(t, options.Icons, 6)
+
+	_, err = Build(options)
+	assert.NotNil(t, err, "webServiceURL is required")
+
+	options.WebServiceURL = "https://push.example.com"
+	packageZip, err := Build(options)
+	require.Nil(t, err)
+	pushPackage, err := Verify(packageZip, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "Example", pushPackage.Website.WebsiteName)
+	assert.Equal(t, "https://example.com/%@", pushPackage.Website.UrlFormatString)
+}
+
+type readCloser struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (reader *readCloser) Close() error {
+	reader.closed = true
+	return nil
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tenants serves several push service applications from one process. A Registry maps tenant keys to the
+// application ID, credentials and service URL of each tenant, and builds the PushServiceV1 client of a tenant the first
+// time it is needed:
+//
+//	registry := tenants.NewRegistry(&pushservicev1.PushServiceV1Options{URL: pushservicev1.DefaultServiceURL})
+//	err := registry.Add("acme", tenants.Config{ApplicationID: "acme-app-id", AppSecret: "acme-app-secret"})
+//	...
+//	client, err := registry.Client("acme")
+//	result, _, err := client.SendMessage(client.NewSendMessageOptions(message))
+//
+// Tenants can be added, replaced and removed while other goroutines use the registry.
+package tenants
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+)
+
+// Config : The application and credentials of a tenant.
+type Config struct {
+	// Unique ID of the application of the tenant [required].
+	ApplicationID string
+
+	// The app secret of the application. When set, every request of the tenant is authenticated with an
+	// AppSecretAuthenticator, which wraps Authenticator if it is set.
+	AppSecret string
+
+	// The authenticator of the tenant. When nil, the authenticator of the registry options is used.
+	Authenticator core.Authenticator
+
+	// The service URL of the tenant, which replaces both the URL and the Region of the registry options. When empty,
+	// those of the registry options are used.
+	URL string
+}
+
+// Client : The push service client of a tenant. Its operations are bound to the application of the tenant; the
+// underlying PushServiceV1 is its PushService.
+type Client struct {
+	*pushservicev1.AppClient
+
+	// The key of the tenant.
+	Key string
+}
+
+// Registry : The tenants served by a process, and their clients. It is safe for concurrent use.
+type Registry struct {
+	options pushservicev1.PushServiceV1Options
+
+	mutex   sync.RWMutex
+	tenants map[string]*tenant
+}
+
+// tenant : A registered tenant. Its client is built once, on first use; replacing the tenant registers a new one.
+type tenant struct {
+	key    string
+	config Config
+	once   sync.Once
+	client *Client
+	err    error
+}
+
+// NewRegistry constructs an empty registry. The options are the defaults of every tenant: their URL and
+// Authenticator are used when the Config of a tenant does not set its own, and their other fields apply to every
+// client. They may be nil.
+func NewRegistry(options *pushservicev1.PushServiceV1Options) *Registry {
+	registry := &Registry{tenants: make(map[string]*tenant)}
+	if options != nil {
+		registry.options = *options
+	}
+	return registry
+}
+
+// Add registers a tenant, replacing the tenant with the same key if there is one. The client of a replaced tenant is
+// not closed: callers which already hold it can finish their requests, and Client returns a new one.
+func (registry *Registry) Add(key string, config Config) error {
+	if key == "" {
+		return fmt.Errorf("the tenant key must not be empty")
+	}
+	if config.ApplicationID == "" {
+		return fmt.Errorf("tenant '%s': ApplicationID is required", key)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.tenants[key] = &tenant{key: key, config: config}
+	return nil
+}
+
+// Remove unregisters a tenant and reports whether it was registered. As with Add, its client keeps working for the
+// callers which hold it.
+func (registry *Registry) Remove(key string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	_, ok := registry.tenants[key]
+	delete(registry.tenants, key)
+	return ok
+}
+
+// Keys returns the keys of the registered tenants, sorted.
+func (registry *Registry) Keys() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	keys := make([]string, 0, len(registry.tenants))
+	for key := range registry.tenants {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Client returns the client of a tenant, building it on the first call. Later calls return the same client until the
+// tenant is replaced or removed.
+func (registry *Registry) Client(key string) (*Client, error) {
+	registry.mutex.RLock()
+	tenant, ok := registry.tenants[key]
+	registry.mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown tenant '%s'", key)
+	}
+
+	// The client is built outside of the registry lock, so that a slow construction does not block the other tenants.
+	tenant.once.Do(func() {
+		tenant.client, tenant.err = registry.newClient(tenant)
+	})
+	return tenant.client, tenant.err
+}
+
+// newClient builds the client of a tenant from its config and the registry options.
+func (registry *Registry) newClient(tenant *tenant) (*Client, error) {
+	options := registry.options
+	if tenant.config.URL != "" {
+		// URL and Region are mutually exclusive.
+		options.URL = tenant.config.URL
+		options.Region = ""
+	}
+	if tenant.config.Authenticator != nil {
+		options.Authenticator = tenant.config.Authenticator
+	}
+	if tenant.config.AppSecret != "" {
+		options.Authenticator = &pushservicev1.AppSecretAuthenticator{
+			AppSecret:     tenant.config.AppSecret,
+			Authenticator: options.Authenticator,
+		}
+	}
+
+	pushService, err := pushservicev1.NewPushServiceV1(&options)
+	if err != nil {
+		return nil, fmt.Errorf("tenant '%s': %s", tenant.key, err.Error())
+	}
+	appClient, err := pushService.NewAppClient(tenant.config.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant '%s': %s", tenant.key, err.Error())
+	}
+	return &Client{AppClient: appClient, Key: tenant.key}, nil
+}
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tenants
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer returns a server which answers GetSettings and records the path and appSecret header of each request.
+func newTestServer(t *testing.T) (*httptest.Server, chan string) {
+	requests := make(chan string, 10)
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests <- req.URL.Path + " " + req.Header.Get("appSecret")
+		res.Header().Set("Content-type", "application/json")
+		fmt.Fprintf(res, `{}`)
+	}))
+	t.Cleanup(testServer.Close)
+	return testServer, requests
+}
+
+func TestRegistryClient(t *testing.T) {
+	defaultServer, defaultRequests := newTestServer(t)
+	tenantServer, tenantRequests := newTestServer(t)
+	registry := NewRegistry(&pushservicev1.PushServiceV1Options{URL: defaultServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app", AppSecret: "acme-secret"}))
+	require.Nil(t, registry.Add("globex", Config{ApplicationID: "globex-app", AppSecret: "globex-secret", URL: tenantServer.URL}))
+	assert.Equal(t, []string{"acme", "globex"}, registry.Keys())
+
+	client, err := registry.Client("acme")
+	require.Nil(t, err)
+	assert.Equal(t, "acme", client.Key)
+	assert.Equal(t, "acme-app", client.ApplicationID)
+	_, _, err = client.GetSettings(nil)
+	require.Nil(t, err)
+	assert.Equal(t, "/apps/acme-app/settings acme-secret", <-defaultRequests)
+
+	client, err = registry.Client("globex")
+	require.Nil(t, err)
+	_, _, err = client.GetSettingsWithContext(context.Background(), nil)
+	require.Nil(t, err)
+	assert.Equal(t, "/apps/globex-app/settings globex-secret", <-tenantRequests)
+
+	// The client is built once and reused.
+	again, err := registry.Client("globex")
+	require.Nil(t, err)
+	assert.Same(t, client, again)
+}
+
+func TestRegistryClientURLOverridesRegion(t *testing.T) {
+	tenantServer, tenantRequests := newTestServer(t)
+	registry := NewRegistry(&pushservicev1.PushServiceV1Options{Region: "us-south", Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app"}))
+	require.Nil(t, registry.Add("globex", Config{ApplicationID: "globex-app", AppSecret: "globex-secret", URL: tenantServer.URL}))
+
+	client, err := registry.Client("acme")
+	require.Nil(t, err)
+	assert.Equal(t, "https://us-south.imfpush.cloud.ibm.com/imfpush/v1", client.PushService.GetServiceURL())
+
+	client, err = registry.Client("globex")
+	require.Nil(t, err)
+	assert.Equal(t, tenantServer.URL, client.PushService.GetServiceURL())
+	_, _, err = client.GetSettings(nil)
+	require.Nil(t, err)
+	assert.Equal(t, "/apps/globex-app/settings globex-secret", <-tenantRequests)
+}
+
+func TestRegistryReplaceAndRemove(t *testing.T) {
+	registry := NewRegistry(&pushservicev1.PushServiceV1Options{Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app"}))
+	client, err := registry.Client("acme")
+	require.Nil(t, err)
+
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app-2"}))
+	replaced, err := registry.Client("acme")
+	require.Nil(t, err)
+	assert.NotSame(t, client, replaced)
+	assert.Equal(t, "acme-app-2", replaced.ApplicationID)
+	assert.Equal(t, "acme-app", client.ApplicationID)
+
+	assert.True(t, registry.Remove("acme"))
+	assert.False(t, registry.Remove("acme"))
+	assert.Empty(t, registry.Keys())
+	_, err = registry.Client("acme")
+	assert.NotNil(t, err)
+}
+
+func TestRegistryErrors(t *testing.T) {
+	registry := NewRegistry(nil)
+	assert.NotNil(t, registry.Add("", Config{ApplicationID: "acme-app"}))
+	assert.NotNil(t, registry.Add("acme", Config{}))
+	assert.Empty(t, registry.Keys())
+
+	// Without an authenticator, the client cannot be built; the error is returned by every call.
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app"}))
+	for i := 0; i < 2; i++ {
+		client, err := registry.Client("acme")
+		assert.Nil(t, client)
+		assert.Contains(t, err.Error(), "tenant 'acme'")
+	}
+
+	// An app secret is enough.
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app", AppSecret: "acme-secret"}))
+	_, err := registry.Client("acme")
+	assert.Nil(t, err)
+}
+
+func TestRegistryConcurrency(t *testing.T) {
+	registry := NewRegistry(&pushservicev1.PushServiceV1Options{Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, registry.Add("acme", Config{ApplicationID: "acme-app"}))
+
+	var wait sync.WaitGroup
+	clients := make([]*Client, 20)
+	for i := range clients {
+		wait.Add(2)
+		go func(i int) {
+			defer wait.Done()
+			clients[i], _ = registry.Client("acme")
+		}(i)
+		go func(i int) {
+			defer wait.Done()
+			key := fmt.Sprintf("tenant-%d", i)
+			assert.Nil(t, registry.Add(key, Config{ApplicationID: key}))
+			_, err := registry.Client(key)
+			assert.Nil(t, err)
+			assert.True(t, registry.Remove(key))
+		}(i)
+	}
+	wait.Wait()
+
+	for _, client := range clients {
+		assert.Same(t, clients[0], client)
+	}
+	assert.Equal(t, []string{"acme"}, registry.Keys())
+}
@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webpushkeys generates, encodes and compares the P-256 VAPID keys which identify an application server to
+// Web Push services, such as the key returned by PushServiceV1.GetWebpushServerKey.
+package webpushkeys
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+)
+
+// PublicKeyLength is the length of a VAPID public key encoded as an uncompressed P-256 point.
+const PublicKeyLength = 65
+
+// PrivateKeyLength is the length of a VAPID private key encoded as a P-256 scalar.
+const PrivateKeyLength = 32
+
+// KeyPair : A VAPID key pair.
+type KeyPair struct {
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// GenerateKeyPair generates a new VAPID key pair.
+func GenerateKeyPair() (*KeyPair, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{PrivateKey: privateKey}, nil
+}
+
+// PublicKey returns the public key as an uncompressed P-256 point, the form browsers expect as applicationServerKey.
+func (keyPair *KeyPair) PublicKey() []byte {
+	return MarshalPublicKey(&keyPair.PrivateKey.PublicKey)
+}
+
+// EncodePublicKey returns the public key as an unpadded base64url string.
+func (keyPair *KeyPair) EncodePublicKey() string {
+	return EncodePublicKey(&keyPair.PrivateKey.PublicKey)
+}
+
+// EncodePrivateKey returns the private key as an unpadded base64url string of its 32 byte scalar.
+func (keyPair *KeyPair) EncodePrivateKey() string {
+	scalar := keyPair.PrivateKey.D.Bytes()
+	padded := make([]byte, PrivateKeyLength)
+	copy(padded[PrivateKeyLength-len(scalar):], scalar)
+	return base64.RawURLEncoding.EncodeToString(padded)
+}
+
+// MarshalPrivateKeyPEM returns the private key as a PEM encoded SEC 1 "EC PRIVATE KEY".
+func (keyPair *KeyPair) MarshalPrivateKeyPEM() ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(keyPair.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// MarshalPublicKeyPEM returns the public key as a PEM encoded PKIX "PUBLIC KEY".
+func (keyPair *KeyPair) MarshalPublicKeyPEM() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(&keyPair.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// DecodePrivateKey returns the key pair of a private key encoded by EncodePrivateKey.
+func DecodePrivateKey(encoded string) (*KeyPair, error) {
+	scalar, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("the private key is not valid base64: %s", err.Error())
+	}
+	if len(scalar) != PrivateKeyLength {
+		return nil, fmt.Errorf("the private key must be %d bytes long, got %d", PrivateKeyLength, len(scalar))
+	}
+
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(scalar)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, fmt.Errorf("the private key is not a valid P-256 scalar")
+	}
+	privateKey := &ecdsa.PrivateKey{D: d}
+	privateKey.PublicKey.Curve = curve
+	privateKey.PublicKey.X, privateKey.PublicKey.Y = curve.ScalarBaseMult(scalar)
+	return &KeyPair{PrivateKey: privateKey}, nil
+}
+
+// ParsePrivateKeyPEM returns the key pair of a PEM encoded SEC 1 or PKCS #8 P-256 private key.
+func ParsePrivateKeyPEM(data []byte) (*KeyPair, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	var privateKey *ecdsa.PrivateKey
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("the private key is not an elliptic curve key")
+		}
+		privateKey = ecKey
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type '%s'", block.Type)
+	}
+	if privateKey.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("the private key is not a P-256 key")
+	}
+	return &KeyPair{PrivateKey: privateKey}, nil
+}
+
+// MarshalPublicKey returns publicKey as an uncompressed P-256 point.
+func MarshalPublicKey(publicKey *ecdsa.PublicKey) []byte {
+	return elliptic.Marshal(elliptic.P256(), publicKey.X, publicKey.Y)
+}
+
+// EncodePublicKey returns publicKey as the unpadded base64url string of its uncompressed point.
+func EncodePublicKey(publicKey *ecdsa.PublicKey) string {
+	return base64.RawURLEncoding.EncodeToString(MarshalPublicKey(publicKey))
+}
+
+// DecodePublicKey returns the public key of an uncompressed P-256 point encoded in base64url or standard base64,
+// with or without padding.
+func DecodePublicKey(encoded string) (*ecdsa.PublicKey, error) {
+	point, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("the public key is not valid base64: %s", err.Error())
+	}
+	return UnmarshalPublicKey(point)
+}
+
+// UnmarshalPublicKey returns the public key of an uncompressed P-256 point.
+func UnmarshalPublicKey(point []byte) (*ecdsa.PublicKey, error) {
+	if len(point) != PublicKeyLength || point[0] != 4 {
+		return nil, fmt.Errorf("the public key must be a %d byte uncompressed P-256 point", PublicKeyLength)
+	}
+	curve := elliptic.P256()
+	x, y := elliptic.Unmarshal(curve, point)
+	if x == nil {
+		return nil, fmt.Errorf("the public key is not a point on the P-256 curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// PublicKeysEqual reports whether two encoded public keys are the same key, regardless of how they are encoded.
+func PublicKeysEqual(a string, b string) (bool, error) {
+	keyA, err := DecodePublicKey(a)
+	if err != nil {
+		return false, err
+	}
+	keyB, err := DecodePublicKey(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(MarshalPublicKey(keyA), MarshalPublicKey(keyB)), nil
+}
+
+// VerifyApplicationServerKey checks that publicKey, such as the key embedded in a front-end, is the application server
+// key returned by the push service.
+func VerifyApplicationServerKey(model *pushservicev1.ApplicationServerKeyModel, publicKey string) error {
+	if model == nil || model.WebpushServerKey == nil {
+		return fmt.Errorf("the push service returned no webpushServerKey")
+	}
+	equal, err := PublicKeysEqual(*model.WebpushServerKey, publicKey)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		return fmt.Errorf("the key %s does not match the webpushServerKey %s of the push service", publicKey, *model.WebpushServerKey)
+	}
+	return nil
+}
+
+// VerifyWithService retrieves the application server key of the application and checks that publicKey matches it.
+func VerifyWithService(ctx context.Context, pushService *pushservicev1.PushServiceV1, applicationID string, publicKey string) error {
+	result, _, err := pushService.GetWebpushServerKeyWithContext(ctx, pushService.NewGetWebpushServerKeyOptions(applicationID))
+	if err != nil {
+		return err
+	}
+	return VerifyApplicationServerKey(result, publicKey)
+}
+
+// decodeBase64 decodes base64url or standard base64, with or without padding.
+func decodeBase64(encoded string) ([]byte, error) {
+	encoded = strings.TrimRight(strings.TrimSpace(encoded), "=")
+	encoded = strings.NewReplacer("+", "-", "/", "_").Replace(encoded)
+	return base64.RawURLEncoding.DecodeString(encoded)
+}
@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webpushkeys
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A key pair generated with openssl, in the format of the web-push tools.
+const (
+	testPublicKey  = "BKc4X-JVTYYN_2csLI562eAwQXsoKcBPUoezF5nDYkgxPIwB5FcpI1tvGxWfc2QOAljrVs6GQAV4eCzMKdKkHKs"
+	testPrivateKey = "14yDHqzKHPNwGkcLh9kd6nK4oe7-Nw7Y1lA038lR4Lg"
+)
+
+func TestGenerateKeyPair(t *testing.T) {
+	keyPair, err := GenerateKeyPair()
+	require.Nil(t, err)
+	assert.Len(t, keyPair.PublicKey(), PublicKeyLength)
+	assert.Equal(t, byte(4), keyPair.PublicKey()[0])
+
+	decoded, err := DecodePrivateKey(keyPair.EncodePrivateKey())
+	require.Nil(t, err)
+	assert.Equal(t, keyPair.EncodePublicKey(), decoded.EncodePublicKey())
+
+	publicKey, err := DecodePublicKey(keyPair.EncodePublicKey())
+	require.Nil(t, err)
+	assert.Equal(t, keyPair.PublicKey(), MarshalPublicKey(publicKey))
+}
+
+func TestDecodePrivateKey(t *testing.T) {
+	keyPair, err := DecodePrivateKey(testPrivateKey)
+	require.Nil(t, err)
+	assert.Equal(t, testPublicKey, keyPair.EncodePublicKey())
+	assert.Equal(t, testPrivateKey, keyPair.EncodePrivateKey())
+
+	_, err = DecodePrivateKey("AAAA")
+	assert.NotNil(t, err)
+	_, err = DecodePrivateKey(base64.RawURLEncoding.EncodeToString(make([]byte, PrivateKeyLength)))
+	assert.NotNil(t, err)
+	_, err = DecodePrivateKey("not base64!")
+	assert.NotNil(t, err)
+}
+
+func TestDecodePublicKey(t *testing.T) {
+	point, err := base64.RawURLEncoding.DecodeString(testPublicKey)
+	require.Nil(t, err)
+
+	for _, encoded := range []string{
+		testPublicKey,
+		base64.URLEncoding.EncodeToString(point),
+		base64.StdEncoding.EncodeToString(point),
+		base64.RawStdEncoding.EncodeToString(point),
+	} {
+		publicKey, err := DecodePublicKey(encoded)
+		require.Nil(t, err, encoded)
+		assert.Equal(t, testPublicKey, EncodePublicKey(publicKey))
+	}
+
+	// A compressed point, a point off the curve and a short key are rejected.
+	compressed := append([]byte{2}, point[1:33]...)
+	_, err = UnmarshalPublicKey(compressed)
+	assert.NotNil(t, err)
+	offCurve := append([]byte(nil), point...)
+	offCurve[64] ^= 1
+	_, err = UnmarshalPublicKey(offCurve)
+	assert.NotNil(t, err)
+	_, err = DecodePublicKey("BKc4X-JV")
+	assert.NotNil(t, err)
+}
+
+func TestPEM(t *testing.T) {
+	keyPair, err := DecodePrivateKey(testPrivateKey)
+	require.Nil(t, err)
+
+	privatePEM, err := keyPair.MarshalPrivateKeyPEM()
+	require.Nil(t, err)
+	parsed, err := ParsePrivateKeyPEM(privatePEM)
+	require.Nil(t, err)
+	assert.Equal(t, testPrivateKey, parsed.EncodePrivateKey())
+
+	publicPEM, err := keyPair.MarshalPublicKeyPEM()
+	require.Nil(t, err)
+	assert.Contains(t, string(publicPEM), "BEGIN PUBLIC KEY")
+
+	_, err = ParsePrivateKeyPEM(publicPEM)
+	assert.NotNil(t, err)
+	_, err = ParsePrivateKeyPEM([]byte("not PEM"))
+	assert.NotNil(t, err)
+}
+
+func TestPublicKeysEqual(t *testing.T) {
+	point, err := base64.RawURLEncoding.DecodeString(testPublicKey)
+	require.Nil(t, err)
+
+	equal, err := PublicKeysEqual(testPublicKey, base64.StdEncoding.EncodeToString(point))
+	require.Nil(t, err)
+	assert.True(t, equal)
+
+	other, err := GenerateKeyPair()
+	require.Nil(t, err)
+	equal, err = PublicKeysEqual(testPublicKey, other.EncodePublicKey())
+	require.Nil(t, err)
+	assert.False(t, equal)
+
+	_, err = PublicKeysEqual(testPublicKey, "invalid")
+	assert.NotNil(t, err)
+}
+
+func TestVerifyApplicationServerKey(t *testing.T) {
+	model := &pushservicev1.ApplicationServerKeyModel{WebpushServerKey: core.StringPtr(testPublicKey)}
+	assert.Nil(t, VerifyApplicationServerKey(model, testPublicKey))
+
+	other, err := GenerateKeyPair()
+	require.Nil(t, err)
+	assert.NotNil(t, VerifyApplicationServerKey(model, other.EncodePublicKey()))
+	assert.NotNil(t, VerifyApplicationServerKey(&pushservicev1.ApplicationServerKeyModel{}, testPublicKey))
+}
+
+func TestVerifyWithService(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/apps/testString/settings/webpushServerKey", req.URL.EscapedPath())
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"webpushServerKey": "%s"}`, testPublicKey)
+	}))
+	defer testServer.Close()
+
+	pushService, err := pushservicev1.NewPushServiceV1(&pushservicev1.PushServiceV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	assert.Nil(t, VerifyWithService(context.Background(), pushService, "testString", testPublicKey))
+	other, err := GenerateKeyPair()
+	require.Nil(t, err)
+	assert.NotNil(t, VerifyWithService(context.Background(), pushService, "testString", other.EncodePublicKey()))
+}